@@ -1,11 +1,25 @@
 package main
 
-import "github.com/sajimenezher_meli/meli-frescos-8/internal/application"
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/application"
+)
 
 func main() {
-	app := application.Application{
-		PortServer: "localhost:8080",
-	}
+	// SIGTERM/SIGINT cancel ctx so the server drains before exiting
+	// SIGTERM/SIGINT cancelan ctx para que el servidor termine las solicitudes en curso antes de salir
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
 
-	app.InitApplication()
+	app := application.Application{}
+	if err := app.InitApplication(ctx); err != nil {
+		slog.Error("api stopped", "error", err)
+		stop()
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
+)
+
+const usage = `usage: config <command>
+
+commands:
+  print           show the effective configuration with secrets redacted
+`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing command\n%s", usage)
+	}
+
+	switch args[0] {
+	case "print":
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+		for _, entry := range cfg.Entries() {
+			fmt.Printf("%s=%s\n", entry.Key, entry.Value)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+}
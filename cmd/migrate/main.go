@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/migrations"
+	"github.com/sajimenezher_meli/meli-frescos-8/pkg/database"
+)
+
+const usage = `usage: migrate <command> [argument]
+
+commands:
+  up              apply all pending migrations
+  down [steps]    revert the last applied migrations (default 1)
+  status          list migrations and whether they are applied
+  to <version>    migrate up or down to the given version (0 reverts all)
+`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing command\n%s", usage)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	db, err := database.InitDB(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		printMigrations("applied", applied)
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid steps %q: must be a positive number", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		printMigrations("reverted", reverted)
+		return err
+
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d  %-40s %s\n", s.Version, s.Name, state)
+		}
+		return nil
+
+	case "to":
+		if len(args) < 2 {
+			return fmt.Errorf("missing version\n%s", usage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid version %q: must be a non-negative number", args[1])
+		}
+		changed, err := migrator.To(ctx, version)
+		printMigrations("migrated", changed)
+		return err
+
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+}
+
+func printMigrations(action string, list []migrations.Migration) {
+	if len(list) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+	for _, m := range list {
+		fmt.Printf("%s %04d_%s\n", action, m.Version, m.Name)
+	}
+}
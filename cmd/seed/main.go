@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/seed"
+	"github.com/sajimenezher_meli/meli-frescos-8/pkg/database"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	profile := flag.String("profile", "demo", "fixture profile to load")
+	dir := flag.String("dir", "docs/database/fixtures", "directory holding one folder per profile")
+	list := flag.Bool("list", false, "list available profiles and exit")
+	flag.Parse()
+
+	if *list {
+		profiles, err := seed.Profiles(*dir)
+		if err != nil {
+			return err
+		}
+		fmt.Println(strings.Join(profiles, "\n"))
+		return nil
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+	db, err := database.InitDB(context.Background(), cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	results, err := seed.NewLoader(db, *dir).Load(context.Background(), *profile)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		fmt.Printf("%-16s inserted=%d updated=%d unchanged=%d\n", r.Entity, r.Inserted, r.Updated, r.Unchanged)
+	}
+	return nil
+}
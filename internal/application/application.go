@@ -1,39 +1,106 @@
 package application
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/container"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/logging"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/routes"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 	"github.com/sajimenezher_meli/meli-frescos-8/pkg/database"
 )
 
-type Application struct {
-	PortServer string
-}
+type Application struct{}
 
-func (app *Application) InitApplication() {
+// InitApplication serves the API until ctx is cancelled, then drains in-flight requests and closes the DB pool
+// InitApplication sirve la API hasta que se cancela ctx, luego espera las solicitudes en curso y cierra el pool de la base
+func (app *Application) InitApplication(ctx context.Context) error {
 	// 1. Load configuration
-	cfg := config.LoadConfig()
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
 
-	// 2. Initialize database
-	db := database.InitDB(cfg)
-	defer db.Close()
+	logger, err := logging.New(os.Stderr, cfg.Application.LogLevel, cfg.Application.LogFormat)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
 
-	c, err := container.NewContainer(db)
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The flush runs after ctx was cancelled, so it gets its own deadline / El envío corre con ctx ya cancelado, así que tiene su propio plazo
+		flushCtx, cancel := context.WithTimeout(context.Background(), cfg.Application.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			slog.Error("error flushing traces", "error", err)
+		}
+	}()
 
+	// 2. Initialize database, unless repositories are kept in memory
+	var db *sql.DB
+	if cfg.Application.Storage != container.StorageMemory {
+		db, err = database.InitDB(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := db.Close(); err != nil {
+				slog.Error("error closing database", "error", err)
+			}
+		}()
+	} else {
+		slog.Warn("using in-memory storage, data is lost on restart")
+	}
+
+	c, err := container.NewContainer(cfg.Application.Storage, db)
 	if err != nil {
-		log.Fatalf("error initialized container dependencies %v", err)
+		return fmt.Errorf("error initialized container dependencies: %w", err)
 	}
 
-	router := routes.SetupRoutes(c)
+	// 3. Build the server with the configured timeouts
+	server := &http.Server{
+		Addr:         cfg.Addr(),
+		Handler:      routes.SetupRoutes(c, cfg.RouteTimeouts),
+		ReadTimeout:  cfg.Application.ReadTimeout,
+		WriteTimeout: cfg.Application.WriteTimeout,
+		IdleTimeout:  cfg.Application.IdleTimeout,
+	}
 
-	log.Printf("Server starting on port http://%s/api/v1", app.PortServer)
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("server starting", "addr", server.Addr)
+		serveErr <- server.ListenAndServe()
+	}()
 
-	if err := http.ListenAndServe(app.PortServer, router); err != nil {
-		panic(fmt.Sprintf("Error starting server: %v", err))
+	// 4. Wait for the server to fail or for a shutdown signal
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("error starting server: %w", err)
+	case <-ctx.Done():
 	}
+
+	slog.Info("shutting down, draining in-flight requests", "timeout", cfg.Application.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Application.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("error shutting down server: %w", err)
+	}
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error serving: %w", err)
+	}
+
+	slog.Info("server stopped")
+	return nil
 }
@@ -0,0 +1,42 @@
+package buildinfo
+
+import "runtime/debug"
+
+// Set at build time with -ldflags, for example
+// Se definen al compilar con -ldflags, por ejemplo
+//
+//	go build -ldflags "-X github.com/sajimenezher_meli/meli-frescos-8/internal/buildinfo.Commit=$(git rev-parse HEAD)" ./cmd/api
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info describes the running binary / Info describe el binario en ejecución
+type Info struct {
+	Version   string
+	Commit    string
+	BuildTime string
+	GoVersion string
+}
+
+// Get returns the ldflags values, falling back to the VCS data Go embeds when they were not set
+// Get devuelve los valores de ldflags, usando los datos de VCS que embebe Go cuando no se definieron
+func Get() Info {
+	info := Info{Version: Version, Commit: Commit, BuildTime: BuildTime}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	info.GoVersion = build.GoVersion
+	for _, setting := range build.Settings {
+		switch {
+		case setting.Key == "vcs.revision" && info.Commit == "":
+			info.Commit = setting.Value
+		case setting.Key == "vcs.time" && info.BuildTime == "":
+			info.BuildTime = setting.Value
+		}
+	}
+	return info
+}
@@ -1,48 +1,291 @@
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"net"
 	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Every field is read from the environment variable in its env tag, falling back to its default tag
+// Fields tagged secret are redacted when the configuration is printed
+// Cada campo se lee de la variable de entorno de su tag env, usando su tag default si no está definida
+// Los campos con tag secret se ocultan al imprimir la configuración
+
 type Database struct {
-	DBUser     string
-	DBPassword string
-	DBHost     string
-	DBPort     string
-	DBName     string
+	DBUser     string `env:"DB_USER"`
+	DBPassword string `env:"DB_PASSWORD" secret:"true"`
+	DBHost     string `env:"DB_HOST" default:"localhost"`
+	DBPort     int    `env:"DB_PORT" default:"3306"`
+	DBName     string `env:"DB_NAME"`
+
+	// Pool / Pool de conexiones
+	MaxOpenConns    int           `env:"DB_MAX_OPEN_CONNS" default:"25"` // 0 means unlimited
+	MaxIdleConns    int           `env:"DB_MAX_IDLE_CONNS" default:"25"`
+	ConnMaxLifetime time.Duration `env:"DB_CONN_MAX_LIFETIME" default:"5m"` // 0 keeps connections forever
+	ConnMaxIdleTime time.Duration `env:"DB_CONN_MAX_IDLE_TIME" default:"1m"`
+
+	// Startup retry, the wait doubles after every failed ping / Reintentos al iniciar, la espera se duplica tras cada ping fallido
+	ConnectAttempts int           `env:"DB_CONNECT_ATTEMPTS" default:"5"`
+	ConnectBackoff  time.Duration `env:"DB_CONNECT_BACKOFF" default:"1s"`
+
+	// DSN options / Opciones del DSN
+	TLS          string        `env:"DB_TLS" default:"false"` // false, true, skip-verify or preferred
+	DialTimeout  time.Duration `env:"DB_DIAL_TIMEOUT" default:"5s"`
+	ReadTimeout  time.Duration `env:"DB_READ_TIMEOUT" default:"30s"`
+	WriteTimeout time.Duration `env:"DB_WRITE_TIMEOUT" default:"30s"`
+	Collation    string        `env:"DB_COLLATION" default:"utf8mb4_general_ci"`
 }
 
 type ConfigApplication struct {
-	Port string
+	Host    string `env:"APP_HOST"` // Interface to listen on, empty listens on all of them
+	Port    int    `env:"APP_PORT" default:"8080"`
+	Storage string `env:"APP_STORAGE" default:"mysql"` // Repository backend: "mysql" or "memory"
+
+	ReadTimeout     time.Duration `env:"APP_READ_TIMEOUT" default:"10s"`     // Max time to read a whole request, body included
+	WriteTimeout    time.Duration `env:"APP_WRITE_TIMEOUT" default:"35s"`    // Max time to write the response, longer than every ROUTE_TIMEOUT_*
+	IdleTimeout     time.Duration `env:"APP_IDLE_TIMEOUT" default:"60s"`     // Max time a keep-alive connection waits for the next request
+	ShutdownTimeout time.Duration `env:"APP_SHUTDOWN_TIMEOUT" default:"20s"` // Max time to drain in-flight requests on SIGTERM/SIGINT
+
+	LogLevel  string `env:"LOG_LEVEL" default:"info"`  // debug, info, warn or error
+	LogFormat string `env:"LOG_FORMAT" default:"json"` // json or text
+}
+
+type Tracing struct {
+	Exporter    string  `env:"TRACING_EXPORTER" default:"none"`    // none, stdout, file or otlp (endpoint from OTEL_EXPORTER_OTLP_ENDPOINT)
+	File        string  `env:"TRACING_FILE" default:"traces.json"` // Spans are appended here when TRACING_EXPORTER is file
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" default:"1"`   // Fraction of new traces recorded, between 0 and 1
+	ServiceName string  `env:"TRACING_SERVICE_NAME" default:"meli-frescos"`
+}
+
+// RouteTimeouts bounds the requests of each route group under /api/v1; each must be below APP_WRITE_TIMEOUT,
+// and 0, which disables the deadline, is only accepted when APP_WRITE_TIMEOUT is 0 too
+// RouteTimeouts limita las solicitudes de cada grupo de rutas bajo /api/v1; cada uno debe ser menor que APP_WRITE_TIMEOUT,
+// y 0, que desactiva el plazo, solo se acepta cuando APP_WRITE_TIMEOUT también es 0
+type RouteTimeouts struct {
+	Default        time.Duration `env:"ROUTE_TIMEOUT_DEFAULT" default:"5s"` // Routes outside the groups below
+	Employees      time.Duration `env:"ROUTE_TIMEOUT_EMPLOYEES" default:"2s"`
+	Buyers         time.Duration `env:"ROUTE_TIMEOUT_BUYERS" default:"2s"`
+	Warehouses     time.Duration `env:"ROUTE_TIMEOUT_WAREHOUSES" default:"30s"`
+	ProductTypes   time.Duration `env:"ROUTE_TIMEOUT_PRODUCT_TYPES" default:"30s"`
+	Sellers        time.Duration `env:"ROUTE_TIMEOUT_SELLERS" default:"30s"`
+	Sections       time.Duration `env:"ROUTE_TIMEOUT_SECTIONS" default:"3s"`
+	Products       time.Duration `env:"ROUTE_TIMEOUT_PRODUCTS" default:"3s"`
+	ProductBatches time.Duration `env:"ROUTE_TIMEOUT_PRODUCT_BATCHES" default:"3s"`
+	PurchaseOrders time.Duration `env:"ROUTE_TIMEOUT_PURCHASE_ORDERS" default:"2s"`
+	ProductRecords time.Duration `env:"ROUTE_TIMEOUT_PRODUCT_RECORDS" default:"3s"`
+	Geography      time.Duration `env:"ROUTE_TIMEOUT_GEOGRAPHY" default:"30s"` // Countries, provinces and localities
+	Carriers       time.Duration `env:"ROUTE_TIMEOUT_CARRIERS" default:"30s"`
+	InboundOrders  time.Duration `env:"ROUTE_TIMEOUT_INBOUND_ORDERS" default:"2s"`
 }
 
 // Config holds the application configuration
 type Config struct {
-	Database    Database
-	Application ConfigApplication
+	Database      Database
+	Application   ConfigApplication
+	Tracing       Tracing
+	RouteTimeouts RouteTimeouts
+}
+
+// DefaultFile is the optional env file overlaid when CONFIG_FILE is not set
+// DefaultFile es el archivo env opcional que se usa cuando CONFIG_FILE no está definida
+const DefaultFile = "config.env"
+
+// LoadConfig builds the configuration from environment variables, then from the env file, then from the defaults
+// The file is config.env, or the one named by CONFIG_FILE, and may be missing unless CONFIG_FILE names it explicitly
+// Every parsing and validation error is reported at once
+// LoadConfig arma la configuración desde las variables de entorno, luego desde el archivo env y luego desde los valores por defecto
+// El archivo es config.env, o el indicado por CONFIG_FILE, y puede no existir salvo que CONFIG_FILE lo indique explícitamente
+// Todos los errores de parseo y validación se reportan juntos
+func LoadConfig() (*Config, error) {
+	if err := loadFile(); err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	var errs []error
+	invalid := map[string]bool{}
+	walk(reflect.ValueOf(cfg).Elem(), func(field reflect.Value, tag reflect.StructTag) {
+		if err := setField(field, tag); err != nil {
+			errs = append(errs, err)
+			invalid[tag.Get("env")] = true
+		}
+	})
+	errs = append(errs, cfg.validate(invalid)...)
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+	return cfg, nil
+}
+
+// loadFile overlays the env file; variables already set in the environment take precedence
+// loadFile aplica el archivo env; las variables ya definidas en el entorno tienen prioridad
+func loadFile() error {
+	path, explicit := os.LookupEnv("CONFIG_FILE")
+	if !explicit {
+		path = DefaultFile
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicit {
+			return nil
+		}
+		return fmt.Errorf("reading config file %s: %w", path, err)
+	}
+	if err := godotenv.Load(path); err != nil {
+		return fmt.Errorf("loading config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Addr is the address the HTTP server listens on / Addr es la dirección en la que escucha el servidor HTTP
+func (c *Config) Addr() string {
+	return net.JoinHostPort(c.Application.Host, strconv.Itoa(c.Application.Port))
 }
 
-// LoadConfig loads configuration from .env file
-func LoadConfig() *Config {
-	err := godotenv.Load("config.env")
+// validate checks the values that parsed correctly but are out of range; keys in invalid already failed parsing
+// validate verifica los valores que se parsearon correctamente pero están fuera de rango; las claves en invalid ya fallaron al parsear
+func (c *Config) validate(invalid map[string]bool) []error {
+	var errs []error
+	check := func(key string, ok bool, format string, args ...any) {
+		if !ok && !invalid[key] {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	app := c.Application
+	check("APP_PORT", app.Port > 0 && app.Port <= 65535, "must be between 1 and 65535, got %d", app.Port)
+	check("APP_STORAGE", app.Storage == "mysql" || app.Storage == "memory", "must be mysql or memory, got %q", app.Storage)
+	check("APP_READ_TIMEOUT", app.ReadTimeout >= 0, "must not be negative")
+	check("APP_WRITE_TIMEOUT", app.WriteTimeout >= 0, "must not be negative")
+	check("APP_IDLE_TIMEOUT", app.IdleTimeout >= 0, "must not be negative")
+	check("APP_SHUTDOWN_TIMEOUT", app.ShutdownTimeout > 0, "must be greater than 0")
+	check("LOG_LEVEL", slices.Contains([]string{"debug", "info", "warn", "error"}, app.LogLevel), "must be debug, info, warn or error, got %q", app.LogLevel)
+	check("LOG_FORMAT", app.LogFormat == "json" || app.LogFormat == "text", "must be json or text, got %q", app.LogFormat)
 
-	if err != nil {
-		log.Fatalf("Error loading .env file%v", err)
+	db := c.Database
+	check("DB_MAX_OPEN_CONNS", db.MaxOpenConns >= 0, "must not be negative, got %d", db.MaxOpenConns)
+	check("DB_MAX_IDLE_CONNS", db.MaxIdleConns >= 0, "must not be negative, got %d", db.MaxIdleConns)
+	check("DB_MAX_IDLE_CONNS", db.MaxOpenConns == 0 || db.MaxIdleConns <= db.MaxOpenConns, "must not exceed DB_MAX_OPEN_CONNS (%d), got %d", db.MaxOpenConns, db.MaxIdleConns)
+	check("DB_CONN_MAX_LIFETIME", db.ConnMaxLifetime >= 0, "must not be negative")
+	check("DB_CONN_MAX_IDLE_TIME", db.ConnMaxIdleTime >= 0, "must not be negative")
+	check("DB_CONNECT_ATTEMPTS", db.ConnectAttempts >= 1, "must be at least 1, got %d", db.ConnectAttempts)
+	check("DB_CONNECT_BACKOFF", db.ConnectBackoff > 0, "must be greater than 0")
+	check("DB_TLS", slices.Contains([]string{"false", "true", "skip-verify", "preferred"}, db.TLS), "must be false, true, skip-verify or preferred, got %q", db.TLS)
+	check("DB_DIAL_TIMEOUT", db.DialTimeout >= 0, "must not be negative")
+	check("DB_READ_TIMEOUT", db.ReadTimeout >= 0, "must not be negative")
+	check("DB_WRITE_TIMEOUT", db.WriteTimeout >= 0, "must not be negative")
+
+	tracing := c.Tracing
+	check("TRACING_EXPORTER", slices.Contains([]string{"none", "stdout", "file", "otlp"}, tracing.Exporter), "must be none, stdout, file or otlp, got %q", tracing.Exporter)
+	check("TRACING_SAMPLE_RATIO", tracing.SampleRatio >= 0 && tracing.SampleRatio <= 1, "must be between 0 and 1, got %g", tracing.SampleRatio)
+	check("TRACING_SERVICE_NAME", tracing.ServiceName != "", "must not be empty")
+
+	// Every route timeout is a duration field of RouteTimeouts. Past APP_WRITE_TIMEOUT net/http drops the connection,
+	// so a route deadline must expire before it for the client to get the 504 envelope
+	// Cada timeout de ruta es un campo duración de RouteTimeouts. Pasado APP_WRITE_TIMEOUT net/http corta la conexión,
+	// así que el plazo de una ruta debe vencer antes para que el cliente reciba el sobre 504
+	walk(reflect.ValueOf(&c.RouteTimeouts).Elem(), func(field reflect.Value, tag reflect.StructTag) {
+		timeout := time.Duration(field.Int())
+		check(tag.Get("env"), timeout >= 0, "must not be negative")
+		if timeout >= 0 && app.WriteTimeout > 0 {
+			check(tag.Get("env"), timeout > 0 && timeout < app.WriteTimeout, "must be greater than 0 and less than APP_WRITE_TIMEOUT (%s), got %s", app.WriteTimeout, timeout)
+		}
+	})
+
+	// The database is only required when repositories use MySQL / La base solo es obligatoria cuando los repositorios usan MySQL
+	if app.Storage == "mysql" {
+		check("DB_HOST", db.DBHost != "", "is required when APP_STORAGE is mysql")
+		check("DB_USER", db.DBUser != "", "is required when APP_STORAGE is mysql")
+		check("DB_NAME", db.DBName != "", "is required when APP_STORAGE is mysql")
+		check("DB_PORT", db.DBPort > 0 && db.DBPort <= 65535, "must be between 1 and 65535, got %d", db.DBPort)
+	}
+	return errs
+}
+
+// Entry is one effective setting as printed by cmd/config / Entry es un valor efectivo tal como lo imprime cmd/config
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Entries lists the effective configuration by variable name, with secrets redacted
+// Entries lista la configuración efectiva por nombre de variable, con los secretos ocultos
+func (c *Config) Entries() []Entry {
+	var entries []Entry
+	walk(reflect.ValueOf(c).Elem(), func(field reflect.Value, tag reflect.StructTag) {
+		value := fmt.Sprint(field.Interface())
+		if tag.Get("secret") == "true" && value != "" {
+			value = "******"
+		}
+		entries = append(entries, Entry{Key: tag.Get("env"), Value: value})
+	})
+	return entries
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// walk calls fn for every field with an env tag, descending into nested structs
+// walk llama a fn por cada campo con tag env, recorriendo los structs anidados
+func walk(v reflect.Value, fn func(field reflect.Value, tag reflect.StructTag)) {
+	for i := 0; i < v.NumField(); i++ {
+		field, info := v.Field(i), v.Type().Field(i)
+		if field.Kind() == reflect.Struct {
+			walk(field, fn)
+			continue
+		}
+		if info.Tag.Get("env") != "" {
+			fn(field, info.Tag)
+		}
+	}
+}
+
+// setField parses the variable, or its default, into field according to the field type
+// setField interpreta la variable, o su valor por defecto, en field según el tipo del campo
+func setField(field reflect.Value, tag reflect.StructTag) error {
+	key := tag.Get("env")
+	raw, ok := os.LookupEnv(key)
+	if !ok || raw == "" {
+		raw = tag.Get("default")
+	}
+	if raw == "" {
+		return nil
 	}
 
-	return &Config{
-		Database: Database{
-			DBUser:     os.Getenv("DB_USER"),
-			DBPassword: os.Getenv("DB_PASSWORD"),
-			DBHost:     os.Getenv("DB_HOST"),
-			DBPort:     os.Getenv("DB_PORT"),
-			DBName:     os.Getenv("DB_NAME"),
-		},
-		Application: ConfigApplication{
-			Port: os.Getenv("APP_PORT"),
-		},
+	switch {
+	case field.Type() == durationType:
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("%s: must be a duration like 15s or 1m, got %q", key, raw)
+		}
+		field.SetInt(int64(duration))
+	case field.Kind() == reflect.Int:
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("%s: must be an integer, got %q", key, raw)
+		}
+		field.SetInt(int64(number))
+	case field.Kind() == reflect.Float64:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("%s: must be a number, got %q", key, raw)
+		}
+		field.SetFloat(number)
+	case field.Kind() == reflect.Bool:
+		flag, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%s: must be true or false, got %q", key, raw)
+		}
+		field.SetBool(flag)
+	default:
+		field.SetString(raw)
 	}
+	return nil
 }
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// clearEnv unsets every configuration variable for the test, restoring them afterwards
+// clearEnv quita todas las variables de configuración durante el test, restaurándolas al terminar
+func clearEnv(t *testing.T) {
+	t.Helper()
+	keys := []string{"CONFIG_FILE"}
+	walk(reflect.ValueOf(&Config{}).Elem(), func(_ reflect.Value, tag reflect.StructTag) {
+		keys = append(keys, tag.Get("env"))
+	})
+	for _, key := range keys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+// setEnv sets the variables for the rest of the test / setEnv define las variables por el resto del test
+func setEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+}
+
+// TestLoadConfigDefaults loads an empty environment in memory mode and gets the default tags
+// TestLoadConfigDefaults carga un entorno vacío en modo memoria y obtiene los tags default
+func TestLoadConfigDefaults(t *testing.T) {
+	clearEnv(t)
+	setEnv(t, map[string]string{"APP_STORAGE": "memory"})
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	checks := []struct {
+		name     string
+		got      any
+		expected any
+	}{
+		{"APP_PORT", cfg.Application.Port, 8080},
+		{"APP_WRITE_TIMEOUT", cfg.Application.WriteTimeout, 35 * time.Second},
+		{"LOG_FORMAT", cfg.Application.LogFormat, "json"},
+		{"DB_HOST", cfg.Database.DBHost, "localhost"},
+		{"DB_USER", cfg.Database.DBUser, ""},
+		{"DB_CONN_MAX_LIFETIME", cfg.Database.ConnMaxLifetime, 5 * time.Minute},
+		{"TRACING_SAMPLE_RATIO", cfg.Tracing.SampleRatio, 1.0},
+		{"ROUTE_TIMEOUT_WAREHOUSES", cfg.RouteTimeouts.Warehouses, 30 * time.Second},
+		{"Addr", cfg.Addr(), ":8080"},
+	}
+	for _, c := range checks {
+		if c.got != c.expected {
+			t.Errorf("%s = %v, expected %v", c.name, c.got, c.expected)
+		}
+	}
+}
+
+// TestLoadConfigErrors covers parse and range errors; a key that failed parsing is not reported twice
+// TestLoadConfigErrors cubre errores de parseo y de rango; una clave que falló al parsear no se reporta dos veces
+func TestLoadConfigErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      map[string]string
+		expected []string // Substrings of the error, each key once / Subcadenas del error, cada clave una vez
+	}{
+		{
+			name:     "integer",
+			env:      map[string]string{"APP_STORAGE": "memory", "APP_PORT": "http"},
+			expected: []string{`APP_PORT: must be an integer, got "http"`},
+		},
+		{
+			name:     "duration",
+			env:      map[string]string{"APP_STORAGE": "memory", "DB_CONNECT_BACKOFF": "10"},
+			expected: []string{`DB_CONNECT_BACKOFF: must be a duration like 15s or 1m, got "10"`},
+		},
+		{
+			name:     "float",
+			env:      map[string]string{"APP_STORAGE": "memory", "TRACING_SAMPLE_RATIO": "half"},
+			expected: []string{`TRACING_SAMPLE_RATIO: must be a number, got "half"`},
+		},
+		{
+			name:     "route timeout above the write timeout",
+			env:      map[string]string{"APP_STORAGE": "memory", "APP_WRITE_TIMEOUT": "10s", "ROUTE_TIMEOUT_SELLERS": "10s"},
+			expected: []string{"ROUTE_TIMEOUT_SELLERS: must be greater than 0 and less than APP_WRITE_TIMEOUT (10s), got 10s", "ROUTE_TIMEOUT_WAREHOUSES"},
+		},
+		{
+			name:     "unbounded route with a write timeout",
+			env:      map[string]string{"APP_STORAGE": "memory", "ROUTE_TIMEOUT_BUYERS": "0s"},
+			expected: []string{"ROUTE_TIMEOUT_BUYERS: must be greater than 0 and less than APP_WRITE_TIMEOUT (35s), got 0s"},
+		},
+		{
+			name: "every error at once",
+			env: map[string]string{
+				"APP_STORAGE": "mysql", "APP_PORT": "70000", "LOG_LEVEL": "loud", "DB_MAX_OPEN_CONNS": "many",
+				"DB_MAX_IDLE_CONNS": "-1", "DB_TLS": "maybe", "ROUTE_TIMEOUT_GEOGRAPHY": "-1s",
+			},
+			expected: []string{
+				"APP_PORT: must be between 1 and 65535, got 70000",
+				`LOG_LEVEL: must be debug, info, warn or error, got "loud"`,
+				`DB_MAX_OPEN_CONNS: must be an integer, got "many"`,
+				"DB_MAX_IDLE_CONNS: must not be negative, got -1",
+				`DB_TLS: must be false, true, skip-verify or preferred, got "maybe"`,
+				"ROUTE_TIMEOUT_GEOGRAPHY: must not be negative",
+				"DB_USER: is required when APP_STORAGE is mysql",
+				"DB_NAME: is required when APP_STORAGE is mysql",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			clearEnv(t)
+			setEnv(t, tc.env)
+
+			cfg, err := LoadConfig()
+			if err == nil {
+				t.Fatalf("expected an error, got %+v", cfg)
+			}
+			for _, expected := range tc.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected %q in:\n%v", expected, err)
+				}
+				key, _, _ := strings.Cut(expected, ":")
+				if count := strings.Count(err.Error(), key+":"); count != 1 {
+					t.Errorf("expected %s to be reported once, got %d times in:\n%v", key, count, err)
+				}
+			}
+		})
+	}
+}
+
+// TestLoadConfigUnboundedRoutes accepts route timeouts of 0 when the write timeout is disabled too
+// TestLoadConfigUnboundedRoutes acepta timeouts de ruta en 0 cuando el write timeout también está desactivado
+func TestLoadConfigUnboundedRoutes(t *testing.T) {
+	clearEnv(t)
+	setEnv(t, map[string]string{"APP_STORAGE": "memory", "APP_WRITE_TIMEOUT": "0s", "ROUTE_TIMEOUT_DEFAULT": "0s"})
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RouteTimeouts.Default != 0 {
+		t.Errorf("expected an unbounded default route timeout, got %s", cfg.RouteTimeouts.Default)
+	}
+}
+
+// TestLoadConfigFile overlays the env file below the environment
+// TestLoadConfigFile aplica el archivo env por debajo del entorno
+func TestLoadConfigFile(t *testing.T) {
+	clearEnv(t)
+	path := filepath.Join(t.TempDir(), "test.env")
+	content := "APP_STORAGE=memory\nAPP_PORT=9000\nLOG_LEVEL=debug\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	setEnv(t, map[string]string{"CONFIG_FILE": path, "APP_PORT": "9100"})
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Application.Port != 9100 {
+		t.Errorf("expected the environment to win with port 9100, got %d", cfg.Application.Port)
+	}
+	if cfg.Application.LogLevel != "debug" || cfg.Application.Storage != "memory" {
+		t.Errorf("expected the file values, got log level %q and storage %q", cfg.Application.LogLevel, cfg.Application.Storage)
+	}
+
+	// An explicit CONFIG_FILE must exist / Un CONFIG_FILE explícito debe existir
+	clearEnv(t)
+	setEnv(t, map[string]string{"CONFIG_FILE": filepath.Join(t.TempDir(), "missing.env")})
+	if _, err := LoadConfig(); err == nil || !strings.Contains(err.Error(), "missing.env") {
+		t.Errorf("expected the missing file to be reported, got %v", err)
+	}
+}
+
+// TestEntries redacts secrets that are set and keeps the rest of the values
+// TestEntries oculta los secretos definidos y conserva el resto de los valores
+func TestEntries(t *testing.T) {
+	cases := []struct {
+		password string
+		expected string
+	}{
+		{"s3cr3t", "******"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		cfg := &Config{Database: Database{DBUser: "meli", DBPassword: tc.password, DBPort: 3306}}
+		values := map[string]string{}
+		for _, entry := range cfg.Entries() {
+			values[entry.Key] = entry.Value
+		}
+		if values["DB_PASSWORD"] != tc.expected {
+			t.Errorf("DB_PASSWORD %q printed as %q, expected %q", tc.password, values["DB_PASSWORD"], tc.expected)
+		}
+		if values["DB_USER"] != "meli" || values["DB_PORT"] != "3306" || values["ROUTE_TIMEOUT_DEFAULT"] != "0s" {
+			t.Errorf("unexpected entries %v", values)
+		}
+	}
+}
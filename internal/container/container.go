@@ -6,8 +6,8 @@ import (
 	"fmt"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/services"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/metrics"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/migrations"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/validations"
 )
 
@@ -15,6 +15,8 @@ type Container struct {
 	EmployeeHandler      handlers.EmployeeHandlerI
 	BuyerHandler         handlers.BuyerHandlerI
 	WarehouseHandler     *handlers.WarehouseHandler
+	ProductTypeHandler   *handlers.ProductTypeHandler
+	GeographyHandler     *handlers.GeographyHandler
 	SellerHandler        *handlers.SellerHandler
 	SectionHandler       handlers.SectionHandlerI
 	ProductBatchHandler  handlers.ProductBatchHandlerI
@@ -24,7 +26,12 @@ type Container struct {
 	LocalityHandler      *handlers.LocalityHandler
 	CarryHandler         *handlers.CarryHandler
 	InboundOrderHandler  handlers.InboundOrderHandlerI
+	DatabaseHandler      *handlers.DatabaseHandler
+	HealthHandler        *handlers.HealthHandler
+	Metrics              *metrics.Metrics
 	StorageDB            *sql.DB
+	Repositories         Repositories
+	Services             Services
 }
 
 // Strategy para manejo de errores
@@ -49,9 +56,22 @@ func (h InitializationErrorHandler) Execute(tasks []Task) error {
 	return nil
 }
 
-func NewContainer(storeDB *sql.DB) (*Container, error) {
+// NewContainer - Wires every handler on top of the given storage backend (StorageMySQL or StorageMemory)
+// storeDB is only required for StorageMySQL and may be nil for StorageMemory
+// Every call builds its own repositories and services, so several containers can coexist in one process
+// NewContainer - Conecta todos los handlers sobre el backend de almacenamiento dado (StorageMySQL o StorageMemory)
+// storeDB solo es requerido para StorageMySQL y puede ser nil para StorageMemory
+// Cada llamada construye sus propios repositorios y servicios, así varios contenedores pueden coexistir en un proceso
+func NewContainer(storage string, storeDB *sql.DB) (*Container, error) {
+	repos, err := newRepositories(storage, storeDB)
+	if err != nil {
+		return nil, err
+	}
+
 	container := &Container{
-		StorageDB: storeDB,
+		StorageDB:    storeDB,
+		Repositories: repos,
+		Services:     newServices(repos),
 	}
 	errorHandler := InitializationErrorHandler{}
 
@@ -59,6 +79,8 @@ func NewContainer(storeDB *sql.DB) (*Container, error) {
 		{"employee handler", container.initializeEmployeeHandler},
 		{"buyer handler", container.initializeBuyerHandler},
 		{"warehouse handler", container.initializeWarehouseHandler},
+		{"product type handler", container.initializeProductTypeHandler},
+		{"geography handler", container.initializeGeographyHandler},
 		{"seller handler", container.initializeSellerHandler},
 		{"section handler", container.initializeSectionHandler},
 		{"product handler", container.initializeProductHandler},
@@ -68,6 +90,9 @@ func NewContainer(storeDB *sql.DB) (*Container, error) {
 		{"locality handler", container.initializeLocalityHandler},
 		{"carry handler", container.initializeCarryHandler},
 		{"inbound order handler", container.initializeInboundOrderHandler},
+		{"database handler", container.initializeDatabaseHandler},
+		{"health handler", container.initializeHealthHandler},
+		{"metrics", container.initializeMetrics},
 	}
 
 	if err := errorHandler.Execute(tasks); err != nil {
@@ -78,108 +103,96 @@ func NewContainer(storeDB *sql.DB) (*Container, error) {
 }
 
 func (c *Container) initializeEmployeeHandler() error {
-	employeeRepository := repositories.GetNewEmployeeMySQLRepository(c.StorageDB)
-	employeeService := services.GetEmployeeService(employeeRepository)
 	employeeValidation := validations.GetEmployeeValidation()
-	c.EmployeeHandler = handlers.GetEmployeeHandler(employeeService, employeeValidation)
+	c.EmployeeHandler = handlers.GetEmployeeHandler(c.Services.Employee, employeeValidation)
 	return nil
 }
 
 func (c *Container) initializeBuyerHandler() error {
-	buyerRepository := repositories.GetNewBuyerMySQLRepository(c.StorageDB)
-	buyerService := services.GetBuyerService(buyerRepository)
-	c.BuyerHandler = handlers.GetBuyerHandler(buyerService)
+	c.BuyerHandler = handlers.GetBuyerHandler(c.Services.Buyer)
 	return nil
 }
 
 func (c *Container) initializeWarehouseHandler() error {
-	repository := repositories.NewWarehouseRepository(c.StorageDB)
-	service := services.NewWarehouseService(repository)
-	c.WarehouseHandler = handlers.NewWarehouseHandler(service)
+	c.WarehouseHandler = handlers.NewWarehouseHandler(c.Services.Warehouse)
+	return nil
+}
+
+func (c *Container) initializeProductTypeHandler() error {
+	c.ProductTypeHandler = handlers.NewProductTypeHandler(c.Services.ProductType)
+	return nil
+}
+
+func (c *Container) initializeGeographyHandler() error {
+	c.GeographyHandler = handlers.NewGeographyHandler(c.Services.Geography)
 	return nil
 }
 
 func (c *Container) initializeSellerHandler() error {
-	sellerRepo := repositories.NewSQLSellerRepository(c.StorageDB)
-	sellerService := services.NewJSONSellerService(sellerRepo)
-	c.SellerHandler = handlers.NewSellerHandler(sellerService)
+	c.SellerHandler = handlers.NewSellerHandler(c.Services.Seller)
 	return nil
 }
 func (c *Container) initializeLocalityHandler() error {
-	localityRepo := repositories.NewSQLLocalityRepository(c.StorageDB)
-	localityService := services.NewSQLLocalityService(localityRepo)
-	c.LocalityHandler = handlers.NewLocalityHandler(localityService)
+	c.LocalityHandler = handlers.NewLocalityHandler(c.Services.Locality)
 	return nil
 }
 
 func (c *Container) initializeSectionHandler() error {
-	warehouseRepository := repositories.NewWarehouseRepository(c.StorageDB)
-	warehouseService := services.NewWarehouseService(warehouseRepository)
-
-	sectionRepository := repositories.GetSectionRepository(c.StorageDB)
-	sectionService := services.GetSectionService(sectionRepository)
 	sectionValidation := validations.GetSectionValidation()
-	c.SectionHandler = handlers.GetSectionHandler(sectionService, warehouseService, sectionValidation)
+	c.SectionHandler = handlers.GetSectionHandler(c.Services.Section, c.Services.Warehouse, c.Services.ProductType, sectionValidation)
 	return nil
 }
 
 func (c *Container) initializeProductHandler() error {
-	productDB := c.StorageDB
-	productRepository := repositories.NewProductRepository(productDB)
-	productService := services.NewProductService(productRepository)
-	c.ProductHandler = handlers.NewProductHandler(productService)
+	c.ProductHandler = handlers.NewProductHandler(c.Services.Product)
 	return nil
 }
 
 func (c *Container) initializeProductRecordHandler() error {
-	productRecordDb := c.StorageDB
-	productRecordRepository := repositories.NewProductRecordRepository(productRecordDb)
-
-	productRepository := repositories.NewProductRepository(productRecordDb)
-	productService := services.NewProductService(productRepository)
-
-	productRecordService := services.NewProductRecordService(productRecordRepository, productService)
-	productRecordHandler := handlers.NewProductRecordHandler(productRecordService)
-
-	c.ProductRecordHandler = productRecordHandler
-
+	c.ProductRecordHandler = handlers.NewProductRecordHandler(c.Services.ProductRecord)
 	return nil
 }
 
 func (c *Container) initializeProductBatchHandler() error {
-	sectionRepository := repositories.GetSectionRepository(c.StorageDB)
-	sectionService := services.GetSectionService(sectionRepository)
-
-	productRepository := repositories.NewProductRepository(c.StorageDB)
-	productService := services.NewProductService(productRepository)
-
-	productBatchRepository := repositories.GetProductBatchRepository(c.StorageDB)
-	productBatchService := services.GetProductBatchService(productBatchRepository)
 	productBatchValidation := validations.GetProductBatchValidation()
-	c.ProductBatchHandler = handlers.GetProductBatchHandler(productBatchService, sectionService, productService, *productBatchValidation)
+	c.ProductBatchHandler = handlers.GetProductBatchHandler(c.Services.ProductBatch, c.Services.Section, c.Services.Product, *productBatchValidation)
 	return nil
 }
 
 func (c *Container) initializePurchaseOrderHandler() error {
-	purchaseOrderRepository := repositories.GetNewPurchaseOrderMySQLRepository(c.StorageDB)
-	buyerRepository := repositories.GetNewBuyerMySQLRepository(c.StorageDB)
-	productRecordsRepository := repositories.NewProductRecordRepository(c.StorageDB)
-
-	purchaseOrderService := services.GetPurchaseOrderService(purchaseOrderRepository, buyerRepository, productRecordsRepository)
-	c.PurchaseOrderHandler = handlers.GetPurchaseOrderHandler(purchaseOrderService)
+	c.PurchaseOrderHandler = handlers.GetPurchaseOrderHandler(c.Services.PurchaseOrder)
 	return nil
 }
 func (c *Container) initializeCarryHandler() error {
-	carryRepo := repositories.NewCarryRepository(c.StorageDB)
-	localityRepo := repositories.NewSQLLocalityRepository(c.StorageDB)
-	carryService := services.NewCarryService(carryRepo, localityRepo)
-	c.CarryHandler = handlers.NewCarryHandler(carryService)
+	c.CarryHandler = handlers.NewCarryHandler(c.Services.Carry)
 	return nil
 }
 func (c *Container) initializeInboundOrderHandler() error {
-	inboundOrderRepository := repositories.GetNewInboundOrderMySQLRepository(c.StorageDB)
-	employeeRepository := repositories.GetNewEmployeeMySQLRepository(c.StorageDB)
-	inboundOrderService := services.GetInboundOrdersService(inboundOrderRepository, employeeRepository)
-	c.InboundOrderHandler = handlers.GetInboundOrderHandler(inboundOrderService)
+	c.InboundOrderHandler = handlers.GetInboundOrderHandler(c.Services.InboundOrder)
+	return nil
+}
+func (c *Container) initializeDatabaseHandler() error {
+	c.DatabaseHandler = handlers.NewDatabaseHandler(c.StorageDB)
+	return nil
+}
+
+// initializeHealthHandler checks pending migrations only when a database is configured
+// initializeHealthHandler verifica migraciones pendientes solo cuando hay una base configurada
+func (c *Container) initializeHealthHandler() error {
+	if c.StorageDB == nil {
+		c.HealthHandler = handlers.NewHealthHandler(nil, nil)
+		return nil
+	}
+
+	migrator, err := migrations.NewMigrator(c.StorageDB)
+	if err != nil {
+		return err
+	}
+	c.HealthHandler = handlers.NewHealthHandler(c.StorageDB, migrator)
+	return nil
+}
+
+func (c *Container) initializeMetrics() error {
+	c.Metrics = metrics.New(c.StorageDB, c.Services.Warehouse, c.Services.ProductBatch)
 	return nil
 }
@@ -0,0 +1,88 @@
+package container
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories/memory"
+)
+
+// Storage backends selectable through APP_STORAGE / Backends de almacenamiento seleccionables mediante APP_STORAGE
+const (
+	StorageMySQL  = "mysql"
+	StorageMemory = "memory"
+)
+
+// Repositories - Set of repositories shared by every handler of the container
+// Repositories - Conjunto de repositorios compartidos por todos los handlers del contenedor
+type Repositories struct {
+	Buyer         repositories.BuyerRepositoryI
+	Employee      repositories.EmployeeRepositoryI
+	Warehouse     repositories.WarehouseRepository
+	ProductType   repositories.ProductTypeRepository
+	Geography     repositories.GeographyRepository
+	Seller        repositories.SellerRepository
+	Locality      repositories.LocalityRepository
+	Section       repositories.SectionRepositoryI
+	Product       repositories.ProductRepository
+	ProductRecord repositories.IProductRecordRepository
+	ProductBatch  repositories.ProductBatchRepositoryI
+	PurchaseOrder repositories.PurchaseOrderRepositoryI
+	Carry         repositories.CarryRepository
+	InboundOrder  repositories.InboundOrderRepositoryI
+}
+
+// newRepositories - Builds the repositories of the requested storage backend
+// newRepositories - Construye los repositorios del backend de almacenamiento solicitado
+func newRepositories(storage string, db *sql.DB) (Repositories, error) {
+	switch storage {
+	case "", StorageMySQL:
+		if db == nil {
+			return Repositories{}, fmt.Errorf("storage %q requires a database connection", StorageMySQL)
+		}
+		return newMySQLRepositories(db), nil
+	case StorageMemory:
+		return newMemoryRepositories(memory.NewStore()), nil
+	default:
+		return Repositories{}, fmt.Errorf("unknown storage %q, expected %q or %q", storage, StorageMySQL, StorageMemory)
+	}
+}
+
+func newMySQLRepositories(db *sql.DB) Repositories {
+	return Repositories{
+		Buyer:         repositories.GetNewBuyerMySQLRepository(db),
+		Employee:      repositories.GetNewEmployeeMySQLRepository(db),
+		Warehouse:     repositories.NewWarehouseRepository(db),
+		ProductType:   repositories.NewProductTypeRepository(db),
+		Geography:     repositories.NewGeographyRepository(db),
+		Seller:        repositories.NewSQLSellerRepository(db),
+		Locality:      repositories.NewSQLLocalityRepository(db),
+		Section:       repositories.GetSectionRepository(db),
+		Product:       repositories.NewProductRepository(db),
+		ProductRecord: repositories.NewProductRecordRepository(db),
+		ProductBatch:  repositories.GetProductBatchRepository(db),
+		PurchaseOrder: repositories.GetNewPurchaseOrderMySQLRepository(db),
+		Carry:         repositories.NewCarryRepository(db),
+		InboundOrder:  repositories.GetNewInboundOrderMySQLRepository(db),
+	}
+}
+
+func newMemoryRepositories(store *memory.Store) Repositories {
+	return Repositories{
+		Buyer:         memory.NewBuyerRepository(store),
+		Employee:      memory.NewEmployeeRepository(store),
+		Warehouse:     memory.NewWarehouseRepository(store),
+		ProductType:   memory.NewProductTypeRepository(store),
+		Geography:     memory.NewGeographyRepository(store),
+		Seller:        memory.NewSellerRepository(store),
+		Locality:      memory.NewLocalityRepository(store),
+		Section:       memory.NewSectionRepository(store),
+		Product:       memory.NewProductRepository(store),
+		ProductRecord: memory.NewProductRecordRepository(store),
+		ProductBatch:  memory.NewProductBatchRepository(store),
+		PurchaseOrder: memory.NewPurchaseOrderRepository(store),
+		Carry:         memory.NewCarryRepository(store),
+		InboundOrder:  memory.NewInboundOrderRepository(store),
+	}
+}
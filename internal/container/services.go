@@ -0,0 +1,45 @@
+package container
+
+import "github.com/sajimenezher_meli/meli-frescos-8/internal/services"
+
+// Services - Set of services owned by the container, built once and shared by every handler
+// Services - Conjunto de servicios propiedad del contenedor, construidos una vez y compartidos por todos los handlers
+type Services struct {
+	Buyer         services.BuyerServiceI
+	Employee      services.EmployeeServiceI
+	Warehouse     services.WarehouseService
+	ProductType   services.ProductTypeService
+	Geography     services.GeographyService
+	Seller        services.SellerService
+	Locality      services.LocalityService
+	Section       services.SectionServiceI
+	Product       services.ProductService
+	ProductRecord services.ProductRecordServiceI
+	ProductBatch  services.ProductBatchServiceI
+	PurchaseOrder services.PurchaseOrderServiceI
+	Carry         services.CarryService
+	InboundOrder  services.InboundOrdersServiceI
+}
+
+// newServices - Builds every service on top of the given repositories
+// newServices - Construye todos los servicios sobre los repositorios dados
+func newServices(repos Repositories) Services {
+	productService := services.NewProductService(repos.Product)
+
+	return Services{
+		Buyer:         services.GetBuyerService(repos.Buyer),
+		Employee:      services.GetEmployeeService(repos.Employee),
+		Warehouse:     services.NewWarehouseService(repos.Warehouse),
+		ProductType:   services.NewProductTypeService(repos.ProductType),
+		Geography:     services.NewGeographyService(repos.Geography),
+		Seller:        services.NewJSONSellerService(repos.Seller, repos.Locality),
+		Locality:      services.NewSQLLocalityService(repos.Locality),
+		Section:       services.GetSectionService(repos.Section),
+		Product:       productService,
+		ProductRecord: services.NewProductRecordService(repos.ProductRecord, productService),
+		ProductBatch:  services.GetProductBatchService(repos.ProductBatch),
+		PurchaseOrder: services.GetPurchaseOrderService(repos.PurchaseOrder, repos.Buyer, repos.ProductRecord),
+		Carry:         services.NewCarryService(repos.Carry, repos.Locality),
+		InboundOrder:  services.GetInboundOrdersService(repos.InboundOrder, repos.Employee),
+	}
+}
@@ -29,6 +29,24 @@ var (
 	// Por ejemplo, intentar crear un pedido con un ID de producto inexistente.
 	ErrDependencyNotFound = errors.New("error: a required dependent entity was not found")
 
+	// ErrHasDependents is returned when deleting a resource would cascade over dependent rows
+	// and the caller did not force the operation (HTTP 409 Conflict).
+	// ErrHasDependents se devuelve cuando eliminar un recurso borraría en cascada filas dependientes
+	// y quien llama no forzó la operación (HTTP 409 Conflict).
+	ErrHasDependents = errors.New("error: the resource still has dependent records")
+
+	// ErrBadRequest is returned when the request cannot be read, like malformed JSON or a non numeric id (HTTP 400 Bad Request).
+	// ErrBadRequest se devuelve cuando la solicitud no se puede leer, como un JSON mal formado o un id no numérico (HTTP 400 Bad Request).
+	ErrBadRequest = errors.New("error: the request is malformed")
+
+	// ErrRequestTimeout is returned when the request is cancelled, like a client that disconnects, before the operation finishes (HTTP 408 Request Timeout).
+	// ErrRequestTimeout se devuelve cuando la solicitud se cancela, como un cliente que se desconecta, antes de terminar la operación (HTTP 408 Request Timeout).
+	ErrRequestTimeout = errors.New("error: the request timed out")
+
+	// ErrDeadlineExceeded is returned when the request outlives the deadline of its route group (HTTP 504 Gateway Timeout).
+	// ErrDeadlineExceeded se devuelve cuando la solicitud supera el plazo de su grupo de rutas (HTTP 504 Gateway Timeout).
+	ErrDeadlineExceeded = errors.New("error: the request exceeded its deadline")
+
 	ErrFailedCheckingExistence = errors.New("error: failed checking existence")
 
 	ErrQueryingReport = errors.New("error: querying report failed")
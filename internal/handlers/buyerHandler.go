@@ -1,16 +1,9 @@
 package handlers
 
 import (
-	"context"
-	"errors"
 	"net/http"
-	"strconv"
-	"time"
 
-	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
-	"github.com/go-chi/chi/v5"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
@@ -49,9 +42,7 @@ type BuyerHandler struct {
 // Retorna una respuesta JSON con todos los compradores o códigos de error apropiados
 func (h *BuyerHandler) GetAll() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var (
 			requestResponse *responses.DataResponse = &responses.DataResponse{}
@@ -62,7 +53,7 @@ func (h *BuyerHandler) GetAll() http.HandlerFunc {
 		// Get all buyers from service layer / Obtener todos los compradores de la capa de servicio
 		buyersMap, err := h.service.GetAll(ctx)
 		if err != nil {
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -81,9 +72,7 @@ func (h *BuyerHandler) GetAll() http.HandlerFunc {
 // Extrae el ID del parámetro de URL y retorna los datos del comprador o códigos de error apropiados
 func (h *BuyerHandler) GetById() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var (
 			requestResponse *responses.DataResponse = &responses.DataResponse{}
@@ -92,22 +81,16 @@ func (h *BuyerHandler) GetById() http.HandlerFunc {
 		)
 
 		// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		id, err := parseIdParam(r)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
 		// Get buyer by ID from service layer / Obtener comprador por ID de la capa de servicio
 		buyer, err = h.service.GetById(ctx, id)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -124,27 +107,19 @@ func (h *BuyerHandler) GetById() http.HandlerFunc {
 // Extrae el ID del parámetro de URL y elimina el comprador
 func (h *BuyerHandler) DeleteById() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		id, err := parseIdParam(r)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
 		// Delete buyer through service layer / Eliminar comprador a través de la capa de servicio
 		err = h.service.DeleteById(ctx, id)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 		w.WriteHeader(http.StatusNoContent)
@@ -157,19 +132,20 @@ func (h *BuyerHandler) DeleteById() http.HandlerFunc {
 // Valida el cuerpo de la solicitud y retorna códigos de estado HTTP apropiados
 func (h *BuyerHandler) PostBuyer() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var requestResponse *responses.DataResponse = &responses.DataResponse{}
 
 		// Parse and validate request body / Parsear y validar cuerpo de la solicitud
 		requestBuyer := requests.BuyerRequest{}
-		request.JSON(r, &requestBuyer)
+		if err := decodeJSON(r, &requestBuyer); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
 
 		err := validations.ValidateBuyerRequestStruct(requestBuyer)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -177,13 +153,7 @@ func (h *BuyerHandler) PostBuyer() http.HandlerFunc {
 		modelBuyer := mappers.GetModelBuyerFromRequest(requestBuyer)
 		buyerDb, err := h.service.Create(ctx, *modelBuyer)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrAlreadyExists) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -201,26 +171,27 @@ func (h *BuyerHandler) PostBuyer() http.HandlerFunc {
 // Extrae el ID del parámetro de URL y actualiza el comprador con datos parciales
 func (h *BuyerHandler) PatchBuyer() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var requestResponse *responses.DataResponse = &responses.DataResponse{}
 
 		// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		id, err := parseIdParam(r)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
 		// Parse and validate request body for partial update / Parsear y validar cuerpo de solicitud para actualización parcial
 		requestBuyer := requests.BuyerRequest{}
-		request.JSON(r, &requestBuyer)
+		if err := decodeJSON(r, &requestBuyer); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
 
 		err = validations.IsNotAnEmptyBuyer(requestBuyer)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -228,18 +199,7 @@ func (h *BuyerHandler) PatchBuyer() http.HandlerFunc {
 		modelBuyer := mappers.GetModelBuyerFromRequest(requestBuyer)
 		buyerDb, err := h.service.Update(ctx, id, *modelBuyer)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			if errors.Is(err, error_message.ErrAlreadyExists) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -1,15 +1,9 @@
 package handlers
 
 import (
-	"context"
-	"encoding/json"
-	"errors"
 	"net/http"
-	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/response"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
@@ -34,21 +28,19 @@ func NewCarryHandler(carryService services.CarryService) *CarryHandler {
 // Create maneja las solicitudes HTTP POST para crear un nuevo transporte
 // Valida el cuerpo de la solicitud, crea el transporte y retorna códigos de estado HTTP apropiados
 func (h *CarryHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	var request requests.CarryRequest
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		response.Error(w, http.StatusBadRequest, "Invalid JSON format")
+	if err := decodeJSON(r, &request); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if err := validations.ValidateCarryRequest(request); err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -59,21 +51,7 @@ func (h *CarryHandler) Create(w http.ResponseWriter, r *http.Request) {
 	newCarry, err := h.carryService.CreateCarry(ctx, carry)
 
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific business logic errors / Manejar errores específicos de lógica de negocio
-		if errors.Is(err, error_message.ErrAlreadyExists) {
-			response.Error(w, http.StatusConflict, err.Error())
-			return
-		}
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusConflict, err.Error())
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error creating carry")
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -90,45 +68,21 @@ func (h *CarryHandler) Create(w http.ResponseWriter, r *http.Request) {
 // GetCarryReportByLocality maneja las solicitudes HTTP GET para recuperar reportes de transporte por localidad
 // Acepta un parámetro de consulta 'id' opcional para filtrar por ID de localidad
 func (h *CarryHandler) GetCarryReportByLocality(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Get and validate optional locality ID parameter / Obtener y validar parámetro opcional de ID de localidad
-	localityIDStr := r.URL.Query().Get("id")
-	var localityID int
-
-	if localityIDStr != "" {
-		// Validate and convert locality ID if provided / Validar y convertir ID de localidad si se proporciona
-		var err error
-		localityID, err = strconv.Atoi(localityIDStr)
-		if err != nil {
-			response.Error(w, http.StatusBadRequest, "Invalid locality ID format")
-			return
-		}
-		if localityID <= 0 {
-			response.Error(w, http.StatusBadRequest, "Locality ID must be a positive number")
-			return
-		}
+	// When absent localityID is 0, the report for all localities / Si falta, localityID es 0, el reporte de todas las localidades
+	localityID, err := parseIdQueryParam(r, "id")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
 	}
-	// If localityIDStr is empty, localityID will be 0 (report for all localities)
-	// Si localityIDStr está vacío, localityID será 0 (reporte para todas las localidades)
 
 	// Get reports from service layer / Obtener reportes de la capa de servicio
 	reports, err := h.carryService.GetCarryReportByLocality(ctx, localityID)
 
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific business logic errors / Manejar errores específicos de lógica de negocio
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, "Locality not found")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error getting carry report by locality")
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+)
+
+// DatabaseHandler exposes the state of the MySQL connection pool
+// DatabaseHandler expone el estado del pool de conexiones de MySQL
+type DatabaseHandler struct {
+	db *sql.DB // nil when repositories are kept in memory / nil cuando los repositorios están en memoria
+}
+
+// NewDatabaseHandler creates a DatabaseHandler over db, which may be nil
+// NewDatabaseHandler crea un DatabaseHandler sobre db, que puede ser nil
+func NewDatabaseHandler(db *sql.DB) *DatabaseHandler {
+	return &DatabaseHandler{db: db}
+}
+
+// Stats handles GET requests returning the pool statistics
+// Stats maneja las solicitudes GET devolviendo las estadísticas del pool
+func (h *DatabaseHandler) Stats(w http.ResponseWriter, r *http.Request) {
+	if h.db == nil {
+		writeError(r.Context(), w, fmt.Errorf("%w: no database pool, storage is memory", error_message.ErrNotFound))
+		return
+	}
+
+	stats := h.db.Stats()
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: responses.DatabaseStatsResponse{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDuration:       stats.WaitDuration.String(),
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	}})
+}
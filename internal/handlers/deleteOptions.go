@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+)
+
+// deleteOptions holds the query flags accepted by delete endpoints
+// deleteOptions contiene los flags de query aceptados por los endpoints de borrado
+type deleteOptions struct {
+	DryRun bool // Only report dependents, do not delete / Solo reportar dependientes, no borrar
+	Force  bool // Delete even if dependents exist / Borrar aunque existan dependientes
+}
+
+// parseDeleteOptions reads the dryRun and force query params, both default to false
+// parseDeleteOptions lee los parámetros dryRun y force, ambos por defecto en false
+func parseDeleteOptions(r *http.Request) (deleteOptions, error) {
+	var opts deleteOptions
+	query := r.URL.Query()
+
+	for name, target := range map[string]*bool{"dryRun": &opts.DryRun, "force": &opts.Force} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return deleteOptions{}, fmt.Errorf("%w: invalid %s parameter: '%s' is not a valid boolean", error_message.ErrBadRequest, name, value)
+		}
+		*target = parsed
+	}
+
+	return opts, nil
+}
@@ -1,18 +1,10 @@
 package handlers
 
 import (
-	"context"
-	"errors"
 	"net/http"
-	"strconv"
-	"time"
 
-	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
 
-	"github.com/go-chi/chi/v5"
-
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
@@ -51,9 +43,7 @@ type EmployeeHandler struct {
 // Retorna una respuesta JSON con todos los empleados o códigos de error apropiados
 func (h *EmployeeHandler) GetAllEmployee() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var (
 			requestResponse  *responses.DataResponse = &responses.DataResponse{}
@@ -64,7 +54,7 @@ func (h *EmployeeHandler) GetAllEmployee() http.HandlerFunc {
 		// Get all employees from service layer / Obtener todos los empleados de la capa de servicio
 		employeeMap, err := h.service.GetAll(ctx)
 		if err != nil {
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -83,9 +73,7 @@ func (h *EmployeeHandler) GetAllEmployee() http.HandlerFunc {
 // Extrae el ID del parámetro de URL y retorna los datos del empleado o códigos de error apropiados
 func (h *EmployeeHandler) GetByIdEmployee() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var (
 			requestResponse  *responses.DataResponse = &responses.DataResponse{}
@@ -94,22 +82,16 @@ func (h *EmployeeHandler) GetByIdEmployee() http.HandlerFunc {
 		)
 
 		// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		id, err := parseIdParam(r)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
 		// Get employee by ID from service layer / Obtener empleado por ID de la capa de servicio
 		employee, err = h.service.GetById(ctx, id)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -126,19 +108,20 @@ func (h *EmployeeHandler) GetByIdEmployee() http.HandlerFunc {
 // Valida el cuerpo de la solicitud y retorna códigos de estado HTTP apropiados
 func (h *EmployeeHandler) PostEmployee() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var requestResponse *responses.DataResponse = &responses.DataResponse{}
 
 		// Parse and validate request body / Parsear y validar cuerpo de la solicitud
 		requestEmployee := requests.EmployeeRequest{}
-		request.JSON(r, &requestEmployee)
+		if err := decodeJSON(r, &requestEmployee); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
 
 		err := validations.ValidateEmployeeRequestStruct(requestEmployee)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -146,13 +129,7 @@ func (h *EmployeeHandler) PostEmployee() http.HandlerFunc {
 		modelEmployee := mappers.GetEmployeeModelFromRequest(requestEmployee)
 		employeeDb, err := h.service.Create(ctx, *modelEmployee)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrAlreadyExists) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -170,26 +147,27 @@ func (h *EmployeeHandler) PostEmployee() http.HandlerFunc {
 // Extrae el ID del parámetro de URL y actualiza el empleado con datos parciales
 func (h *EmployeeHandler) PatchEmployee() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var requestResponse *responses.DataResponse = &responses.DataResponse{}
 
 		// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		id, err := parseIdParam(r)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
 		// Parse and validate request body for partial update / Parsear y validar cuerpo de solicitud para actualización parcial
 		requestEmployee := requests.EmployeeRequest{}
-		request.JSON(r, &requestEmployee)
+		if err := decodeJSON(r, &requestEmployee); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
 
 		err = validations.IsNotAnEmptyEmployee(requestEmployee)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -197,18 +175,7 @@ func (h *EmployeeHandler) PatchEmployee() http.HandlerFunc {
 		modelEmployee := mappers.GetEmployeeModelFromRequest(requestEmployee)
 		employeeDb, err := h.service.Update(ctx, id, *modelEmployee)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			if errors.Is(err, error_message.ErrAlreadyExists) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -226,27 +193,19 @@ func (h *EmployeeHandler) PatchEmployee() http.HandlerFunc {
 // Extrae el ID del parámetro de URL y elimina el empleado
 func (h *EmployeeHandler) DeleteByIdEmployee() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		id, err := parseIdParam(r)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
 		// Delete employee through service layer / Eliminar empleado a través de la capa de servicio
 		err = h.service.DeleteById(ctx, id)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 		w.WriteHeader(http.StatusNoContent)
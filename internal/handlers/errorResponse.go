@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/bootcamp-go/web/response"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/i18n"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/logging"
+)
+
+// errorMapping ties an error_message sentinel to its machine readable code and HTTP status
+// errorMapping asocia un sentinel de error_message con su código legible por máquinas y su estado HTTP
+type errorMapping struct {
+	err    error
+	code   string
+	status int
+}
+
+// errorMappings is the single place where errors become HTTP statuses; the first match wins,
+// so specific sentinels go before the generic internal ones
+// errorMappings es el único lugar donde los errores se convierten en estados HTTP; gana la primera coincidencia,
+// así que los sentinels específicos van antes que los internos genéricos
+var errorMappings = []errorMapping{
+	{error_message.ErrDeadlineExceeded, "deadline_exceeded", http.StatusGatewayTimeout},
+	{error_message.ErrRequestTimeout, "request_timeout", http.StatusRequestTimeout},
+	{error_message.ErrBadRequest, "bad_request", http.StatusBadRequest},
+	{error_message.ErrInvalidInput, "invalid_input", http.StatusUnprocessableEntity},
+	{error_message.ErrNotFound, "not_found", http.StatusNotFound},
+	{error_message.ErrAlreadyExists, "already_exists", http.StatusConflict},
+	{error_message.ErrHasDependents, "has_dependents", http.StatusConflict},
+	{error_message.ErrDependencyNotFound, "dependency_not_found", http.StatusConflict},
+	{error_message.ErrFailedCheckingExistence, "internal_error", http.StatusInternalServerError},
+	{error_message.ErrQueryingReport, "internal_error", http.StatusInternalServerError},
+	{error_message.ErrFailedToScan, "internal_error", http.StatusInternalServerError},
+	{error_message.ErrQuery, "internal_error", http.StatusInternalServerError},
+	{error_message.ErrInternalServerError, "internal_error", http.StatusInternalServerError},
+}
+
+// WriteRequestError writes the error envelope for middlewares that reject a request before its handler runs
+// WriteRequestError escribe el sobre de error para middlewares que rechazan una solicitud antes de su handler
+func WriteRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(r.Context(), w, err)
+}
+
+// writeError writes the error envelope for err in the language negotiated for the request
+// The message comes from the i18n catalog by code, detail keeps what the error adds to its sentinel,
+// a context past its deadline is reported as 504, a cancelled one as 408, and unexpected errors are logged and answered without detail
+// writeError escribe el sobre de error para err en el idioma negociado para la solicitud
+// El mensaje sale del catálogo de i18n por código, detail conserva lo que el error agrega a su sentinel,
+// un contexto vencido se reporta como 504, uno cancelado como 408, y los errores inesperados se registran y se responden sin detalle
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	lang := i18n.LangFrom(ctx)
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		err = error_message.ErrDeadlineExceeded
+	case ctx.Err() != nil:
+		err = error_message.ErrRequestTimeout
+	}
+
+	var body responses.ErrorResponse
+	if fields := validationFields(lang, err); fields != nil {
+		body.Errors = fields
+		err = error_message.ErrInvalidInput
+	}
+
+	mapping, matched := errorMapping{err: error_message.ErrInternalServerError, code: "internal_error", status: http.StatusInternalServerError}, false
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			mapping, matched = m, true
+			break
+		}
+	}
+
+	if mapping.status == http.StatusInternalServerError {
+		// Internal details stay in the logs; internal sentinels were logged where they were wrapped
+		// Los detalles internos quedan en los logs; los sentinels internos se registraron donde se envolvieron
+		if !matched {
+			slog.ErrorContext(ctx, "handlers: unexpected error", "error", err)
+		}
+	} else {
+		body.Detail = errorDetail(err, mapping.err)
+	}
+
+	body.Status = http.StatusText(mapping.status)
+	body.Code = mapping.code
+	body.Message, _ = i18n.Message(lang, mapping.code)
+	body.RequestID = logging.RequestID(ctx)
+	response.JSON(w, mapping.status, body)
+}
+
+// errorDetail returns the context err adds after its sentinel, like "warehouse with id 9" in
+// "error: the requested resource was not found: warehouse with id 9"
+// errorDetail devuelve el contexto que err agrega después de su sentinel, como "warehouse with id 9" en
+// "error: the requested resource was not found: warehouse with id 9"
+func errorDetail(err, sentinel error) string {
+	detail, found := strings.CutPrefix(err.Error(), sentinel.Error())
+	if !found {
+		return ""
+	}
+	return strings.TrimLeft(detail, " :.-")
+}
+
+// validationFields flattens ozzo-validation errors into field -> message, translating the messages
+// whose code is in the i18n catalog; nil for other errors
+// validationFields aplana los errores de ozzo-validation en campo -> mensaje, traduciendo los mensajes
+// cuyo código está en el catálogo de i18n; nil para otros errores
+func validationFields(lang string, err error) map[string]string {
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+
+	fields := make(map[string]string, len(errs))
+	for field, fieldErr := range errs {
+		if validationErr, ok := fieldErr.(validation.Error); ok {
+			if message, ok := i18n.Message(lang, validationErr.Code()); ok {
+				fieldErr = validationErr.SetMessage(message)
+			}
+		}
+		fields[field] = fieldErr.Error()
+	}
+	return fields
+}
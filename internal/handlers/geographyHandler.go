@@ -0,0 +1,339 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/services"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/validations"
+)
+
+// GeographyHandler handles HTTP requests for countries, provinces and localities
+// GeographyHandler maneja las solicitudes HTTP para países, provincias y localidades
+type GeographyHandler struct {
+	geographyService services.GeographyService // Service layer for geography business logic / Capa de servicio para lógica de negocio de geografía
+}
+
+// NewGeographyHandler creates and returns a new instance of GeographyHandler with the required service
+// NewGeographyHandler crea y retorna una nueva instancia de GeographyHandler con el servicio requerido
+func NewGeographyHandler(geographyService services.GeographyService) *GeographyHandler {
+	return &GeographyHandler{geographyService: geographyService}
+}
+
+// GetCountries handles GET /countries / GetCountries maneja GET /countries
+func (h *GeographyHandler) GetCountries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	countries, err := h.geographyService.GetCountries(ctx)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	countryResponses := make([]responses.CountryResponse, 0, len(countries))
+	for _, country := range countries {
+		countryResponses = append(countryResponses, mappers.ToCountryResponse(country))
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: countryResponses})
+}
+
+// GetCountryById handles GET /countries/{id} / GetCountryById maneja GET /countries/{id}
+func (h *GeographyHandler) GetCountryById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	country, err := h.geographyService.GetCountryById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToCountryResponse(country)})
+}
+
+// UpdateCountry handles PATCH /countries/{id} / UpdateCountry maneja PATCH /countries/{id}
+func (h *GeographyHandler) UpdateCountry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	var patch requests.CountryPatchRequest
+	if err := decodeJSON(r, &patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if err := validations.ValidateCountryPatchRequest(patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	existing, err := h.geographyService.GetCountryById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	country, err := h.geographyService.UpdateCountry(ctx, mappers.ApplyCountryPatch(existing, patch))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToCountryResponse(country)})
+}
+
+// MergeCountries handles POST /countries/{id}/merge / MergeCountries maneja POST /countries/{id}/merge
+func (h *GeographyHandler) MergeCountries(w http.ResponseWriter, r *http.Request) {
+	h.merge(w, r, h.geographyService.MergeCountries)
+}
+
+// GetProvinces handles GET /provinces with an optional ?country_id filter
+// GetProvinces maneja GET /provinces con un filtro opcional ?country_id
+func (h *GeographyHandler) GetProvinces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	countryId, err := parseIdQueryParam(r, "country_id")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	provinces, err := h.geographyService.GetProvinces(ctx, countryId)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	provinceResponses := make([]responses.ProvinceResponse, 0, len(provinces))
+	for _, province := range provinces {
+		provinceResponses = append(provinceResponses, mappers.ToProvinceResponse(province))
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: provinceResponses})
+}
+
+// GetProvinceById handles GET /provinces/{id} / GetProvinceById maneja GET /provinces/{id}
+func (h *GeographyHandler) GetProvinceById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	province, err := h.geographyService.GetProvinceById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToProvinceResponse(province)})
+}
+
+// UpdateProvince handles PATCH /provinces/{id}, renaming or moving the province to another country
+// UpdateProvince maneja PATCH /provinces/{id}, renombrando o moviendo la provincia a otro país
+func (h *GeographyHandler) UpdateProvince(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	var patch requests.ProvincePatchRequest
+	if err := decodeJSON(r, &patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if err := validations.ValidateProvincePatchRequest(patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	existing, err := h.geographyService.GetProvinceById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	province, err := h.geographyService.UpdateProvince(ctx, mappers.ApplyProvincePatch(existing, patch))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToProvinceResponse(province)})
+}
+
+// MergeProvinces handles POST /provinces/{id}/merge / MergeProvinces maneja POST /provinces/{id}/merge
+func (h *GeographyHandler) MergeProvinces(w http.ResponseWriter, r *http.Request) {
+	h.merge(w, r, h.geographyService.MergeProvinces)
+}
+
+// GetLocalities handles GET /localities with an optional ?province_id filter
+// GetLocalities maneja GET /localities con un filtro opcional ?province_id
+func (h *GeographyHandler) GetLocalities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	provinceId, err := parseIdQueryParam(r, "province_id")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	localities, err := h.geographyService.GetLocalities(ctx, provinceId)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	localityResponses := make([]responses.LocalityResponse, 0, len(localities))
+	for _, locality := range localities {
+		localityResponses = append(localityResponses, mappers.ToLocalityResponse(locality))
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: localityResponses})
+}
+
+// GetLocalityById handles GET /localities/{id} / GetLocalityById maneja GET /localities/{id}
+func (h *GeographyHandler) GetLocalityById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	locality, err := h.geographyService.GetLocalityById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToLocalityResponse(locality)})
+}
+
+// UpdateLocality handles PATCH /localities/{id}, renaming or moving the locality to another province
+// UpdateLocality maneja PATCH /localities/{id}, renombrando o moviendo la localidad a otra provincia
+func (h *GeographyHandler) UpdateLocality(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	var patch requests.LocalityPatchRequest
+	if err := decodeJSON(r, &patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if err := validations.ValidateLocalityPatchRequest(patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	existing, err := h.geographyService.GetLocalityById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	locality, err := h.geographyService.UpdateLocality(ctx, mappers.ApplyLocalityPatch(existing, patch))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToLocalityResponse(locality)})
+}
+
+// MergeLocalities handles POST /localities/{id}/merge, folding a duplicate locality into target_id
+// MergeLocalities maneja POST /localities/{id}/merge, fusionando una localidad duplicada en target_id
+func (h *GeographyHandler) MergeLocalities(w http.ResponseWriter, r *http.Request) {
+	h.merge(w, r, h.geographyService.MergeLocalities)
+}
+
+// SearchLocalities handles GET /localities/search?q=&limit=, matching locality, province or country names
+// ignoring case and accents; limit defaults to 20 and is capped at 100
+// SearchLocalities maneja GET /localities/search?q=&limit=, buscando por nombre de localidad, provincia o país
+// sin distinguir mayúsculas ni acentos; limit vale 20 por defecto y su máximo es 100
+func (h *GeographyHandler) SearchLocalities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 20
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > 100 {
+			writeError(ctx, w, fmt.Errorf("%w: invalid limit %q: must be a number between 1 and 100", error_message.ErrBadRequest, value))
+			return
+		}
+		limit = parsed
+	}
+
+	matches, err := h.geographyService.SearchLocalities(ctx, r.URL.Query().Get("q"), limit)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	matchResponses := make([]responses.LocalitySearchResponse, 0, len(matches))
+	for _, match := range matches {
+		matchResponses = append(matchResponses, mappers.ToLocalitySearchResponse(match))
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: matchResponses})
+}
+
+// GetTree handles GET /countries/tree / GetTree maneja GET /countries/tree
+func (h *GeographyHandler) GetTree(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tree, err := h.geographyService.GetTree(ctx)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToGeographyTreeResponse(tree)})
+}
+
+// merge parses the source id from the URL and the target from the body, then runs the given merge
+// merge parsea el id origen de la URL y el destino del cuerpo, y luego ejecuta la fusión dada
+func (h *GeographyHandler) merge(w http.ResponseWriter, r *http.Request, mergeFn func(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)) {
+	ctx := r.Context()
+
+	sourceId, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	var request requests.GeographyMergeRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if err := validations.ValidateGeographyMergeRequest(request); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	result, err := mergeFn(ctx, sourceId, request.TargetId)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToGeographyMergeResponse(result)})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/buildinfo"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/migrations"
+)
+
+// readinessTimeout bounds the checks of a single probe / readinessTimeout limita los chequeos de una prueba
+const readinessTimeout = 2 * time.Second
+
+// checkUnavailable is the result of a failed check; the error itself is only logged, never exposed to the caller
+// checkUnavailable es el resultado de un chequeo fallido; el error solo se registra en el log, nunca se expone a quien llama
+const checkUnavailable = "unavailable"
+
+// PendingMigrations reports the migrations not applied yet, implemented by migrations.Migrator
+// PendingMigrations reporta las migraciones aún no aplicadas, implementado por migrations.Migrator
+type PendingMigrations interface {
+	Pending(ctx context.Context) ([]migrations.Migration, error)
+}
+
+// HealthHandler answers the orchestrator probes and the build information
+// HealthHandler responde las pruebas del orquestador y la información del build
+type HealthHandler struct {
+	db       *sql.DB           // nil when repositories are kept in memory / nil cuando los repositorios están en memoria
+	migrator PendingMigrations // nil when repositories are kept in memory / nil cuando los repositorios están en memoria
+}
+
+// NewHealthHandler creates a HealthHandler; db and migrator are nil for in-memory storage
+// NewHealthHandler crea un HealthHandler; db y migrator son nil para el almacenamiento en memoria
+func NewHealthHandler(db *sql.DB, migrator PendingMigrations) *HealthHandler {
+	return &HealthHandler{db: db, migrator: migrator}
+}
+
+// Healthz answers 200 while the process is able to serve requests
+// Healthz responde 200 mientras el proceso puede atender solicitudes
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, responses.HealthResponse{Status: "ok"})
+}
+
+// Readyz answers 200 when the database responds and has no pending migrations, 503 otherwise
+// Readyz responde 200 cuando la base responde y no tiene migraciones pendientes, 503 en caso contrario
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	checks := map[string]string{}
+	if h.db != nil {
+		checks["database"] = "ok"
+		if err := h.db.PingContext(ctx); err != nil {
+			slog.ErrorContext(ctx, "readiness: database ping", "error", err)
+			checks["database"] = checkUnavailable
+		}
+	}
+	if h.migrator != nil {
+		checks["migrations"] = "ok"
+		pending, err := h.migrator.Pending(ctx)
+		switch {
+		case err != nil:
+			slog.ErrorContext(ctx, "readiness: pending migrations", "error", err)
+			checks["migrations"] = checkUnavailable
+		case len(pending) > 0:
+			checks["migrations"] = fmt.Sprintf("%d pending, first is %04d_%s", len(pending), pending[0].Version, pending[0].Name)
+		}
+	}
+
+	status, body := http.StatusOK, responses.ReadinessResponse{Status: "ready", Checks: checks}
+	for _, result := range checks {
+		if result != "ok" {
+			status, body.Status = http.StatusServiceUnavailable, "not_ready"
+		}
+	}
+	response.JSON(w, status, body)
+}
+
+// Version answers the commit and build time set with -ldflags
+// Version responde el commit y la fecha de build definidos con -ldflags
+func (h *HealthHandler) Version(w http.ResponseWriter, r *http.Request) {
+	info := buildinfo.Get()
+	response.JSON(w, http.StatusOK, responses.VersionResponse{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildTime: info.BuildTime,
+		GoVersion: info.GoVersion,
+	})
+}
@@ -1,11 +1,8 @@
 package handlers
 
 import (
-	"context"
-	"errors"
+	"fmt"
 	"net/http"
-	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
@@ -45,27 +42,23 @@ type InboundOrderHandler struct {
 // Acepta un parámetro de consulta 'id' opcional para filtrar por ID de empleado
 func (h *InboundOrderHandler) GetInboundOrdersReport() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var requestResponse *responses.DataResponse = &responses.DataResponse{}
 		var responseData []models.InboundOrderReport
 
 		// Get and validate optional employee ID query parameter / Obtener y validar parámetro opcional de consulta ID de empleado
-		queryId := r.URL.Query().Get("id")
-		if queryId != "" {
-			// Parse and validate employee ID / Parsear y validar ID de empleado
-			employeeId, err := strconv.Atoi(queryId)
-			if err != nil {
-				response.Error(w, http.StatusBadRequest, "Invalid employee ID")
-				return
-			}
+		employeeId, err := parseIdQueryParam(r, "id")
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
 
+		if employeeId != 0 {
 			// Get report for specific employee / Obtener reporte para empleado específico
 			report, err := h.service.GetInboundOrdersReportByEmployeeId(ctx, employeeId)
 			if err != nil {
-				response.Error(w, http.StatusInternalServerError, err.Error())
+				writeError(ctx, w, err)
 				return
 			}
 
@@ -74,7 +67,7 @@ func (h *InboundOrderHandler) GetInboundOrdersReport() http.HandlerFunc {
 			// Get reports for all employees / Obtener reportes para todos los empleados
 			reports, err := h.service.GetAllInboundOrdersReports(ctx)
 			if err != nil {
-				response.Error(w, http.StatusInternalServerError, err.Error())
+				writeError(ctx, w, err)
 				return
 			}
 			responseData = reports
@@ -91,9 +84,7 @@ func (h *InboundOrderHandler) GetInboundOrdersReport() http.HandlerFunc {
 // Valida el cuerpo de la solicitud y retorna códigos de estado HTTP apropiados
 func (h *InboundOrderHandler) PostInboundOrder() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var (
 			reqResponse    *responses.DataResponse      = &responses.DataResponse{}
@@ -103,14 +94,14 @@ func (h *InboundOrderHandler) PostInboundOrder() http.HandlerFunc {
 		// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
 		err := request.JSON(r, &requestInbound)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, fmt.Errorf("%w: %v", error_message.ErrBadRequest, err))
 			return
 		}
 
 		// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 		err = validations.ValidateInboundOrderRequestStruct(requestInbound)
 		if err != nil {
-			response.Error(w, http.StatusUnprocessableEntity, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -118,18 +109,8 @@ func (h *InboundOrderHandler) PostInboundOrder() http.HandlerFunc {
 		modelInbound := mappers.GetModelInboundOrderFromRequest(requestInbound)
 		order, err := h.service.Create(ctx, *modelInbound)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			switch {
-			case errors.Is(err, error_message.ErrAlreadyExists):
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			case errors.Is(err, error_message.ErrDependencyNotFound):
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			default:
-				response.Error(w, http.StatusInternalServerError, err.Error())
-				return
-			}
+			writeError(ctx, w, err)
+			return
 		}
 
 		// Map model to response format / Mapear modelo a formato de respuesta
@@ -1,18 +1,15 @@
 package handlers
 
 import (
-	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/response"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/services"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/validations"
 )
@@ -34,43 +31,29 @@ func NewLocalityHandler(service services.LocalityService) *LocalityHandler {
 // Save maneja las solicitudes HTTP POST para crear una nueva localidad
 // Valida el cuerpo de la solicitud y retorna códigos de estado HTTP apropiados
 func (h *LocalityHandler) Save(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	var localityToCreate requests.LocalityRequest
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	errorBody := json.NewDecoder(r.Body).Decode(&localityToCreate)
-	if errorBody != nil {
-		response.Error(w, http.StatusBadRequest, errorBody.Error())
+	if err := decodeJSON(r, &localityToCreate); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Extract data from request / Extraer datos de la solicitud
 	data := localityToCreate.Data
-	fmt.Println(data)
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if err := validations.ValidateLocalityRequestStruct(data); err != nil {
-		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	parentCtx := context.Background()
-	ctx, cancel := context.WithTimeout(parentCtx, 2*time.Second)
-	defer cancel()
-
 	// Create locality through service layer / Crear localidad a través de la capa de servicio
 	localityCreated, err := h.service.Save(ctx, data)
 	if err != nil {
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrQuery) {
-			response.Error(w, http.StatusInternalServerError, err.Error())
-		}
-		if errors.Is(err, error_message.ErrAlreadyExists) {
-			response.Error(w, http.StatusConflict, err.Error())
-		}
-		if errors.Is(err, context.DeadlineExceeded) {
-			response.Error(w, http.StatusGatewayTimeout, err.Error())
-		}
+		writeError(ctx, w, err)
 		return
 	}
 	response.JSON(w, http.StatusOK, responses.DataResponse{Data: localityCreated})
@@ -81,50 +64,59 @@ func (h *LocalityHandler) Save(w http.ResponseWriter, r *http.Request) {
 // GetSellerReportByLocality maneja las solicitudes HTTP GET para recuperar reportes de vendedores por localidad
 // Acepta un parámetro de consulta 'id' opcional para filtrar por ID de localidad
 func (h *LocalityHandler) GetSellerReportByLocality(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	parentCtx := context.Background()
-	ctx, cancel := context.WithTimeout(parentCtx, 2*time.Second)
-	defer cancel()
-
-	// Get and validate optional locality ID query parameter / Obtener y validar parámetro opcional de consulta ID de localidad
-	localityIdStr := r.URL.Query().Get("id")
-	var localityId int
+	ctx := r.Context()
 
 	// Validate query parameter format / Validar formato del parámetro de consulta
-	if r.URL.RawQuery != "" && localityIdStr == "" {
-		response.Error(w, http.StatusInternalServerError, "Invalid query parameter")
+	if r.URL.RawQuery != "" && r.URL.Query().Get("id") == "" {
+		writeError(ctx, w, fmt.Errorf("%w: only the id query parameter is supported", error_message.ErrBadRequest))
 		return
 	}
 
-	if localityIdStr == "" {
-		// If no parameter provided, use 0 as default (all localities) / Si no se proporciona parámetro, usar 0 por defecto (todas las localidades)
-		localityId = 0
-	} else {
-		// If parameter provided, attempt to convert to int / Si se proporciona parámetro, intentar convertir a int
-		var err error
-		localityId, err = strconv.Atoi(localityIdStr)
-		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
-			return
-		}
+	// Optional locality ID, 0 means all localities / ID de localidad opcional, 0 significa todas las localidades
+	localityId, err := parseIdQueryParam(r, "id")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
 	}
 
 	// Get seller reports from service layer / Obtener reportes de vendedores de la capa de servicio
 	result, err := h.service.GetSellerReports(ctx, localityId)
-
-	// Handle specific error types / Manejar tipos de error específicos
-	if errors.Is(err, error_message.ErrFailedCheckingExistence) || errors.Is(err, error_message.ErrQueryingReport) || errors.Is(err, error_message.ErrFailedToScan) {
-		response.Error(w, http.StatusInternalServerError, err.Error())
+	if err != nil {
+		writeError(ctx, w, err)
 		return
 	}
-	if errors.Is(err, error_message.ErrNotFound) {
-		response.Error(w, http.StatusNotFound, err.Error())
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: result})
+}
+
+// GetDashboard handles HTTP GET requests for the locality dashboard
+// Accepts ?level=locality|province|country (default locality) and an optional ?id to keep a single row
+// GetDashboard maneja las solicitudes HTTP GET del tablero de localidades
+// Acepta ?level=locality|province|country (por defecto locality) y un ?id opcional para conservar una única fila
+func (h *LocalityHandler) GetDashboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	level := r.URL.Query().Get("level")
+	if level == "" {
+		level = models.GeographyLevelLocality
+	}
+
+	// Validate optional id query parameter / Validar parámetro de consulta id opcional
+	id, err := parseIdQueryParam(r, "id")
+	if err != nil {
+		writeError(ctx, w, err)
 		return
 	}
-	if errors.Is(err, context.DeadlineExceeded) {
-		response.Error(w, http.StatusGatewayTimeout, err.Error())
+
+	dashboard, err := h.service.GetDashboard(ctx, level, id)
+	if err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, responses.DataResponse{result})
+	dashboardResponses := make([]responses.LocalityDashboardResponse, 0, len(dashboard))
+	for _, row := range dashboard {
+		dashboardResponses = append(dashboardResponses, mappers.ToLocalityDashboardResponse(row))
+	}
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: dashboardResponses})
 }
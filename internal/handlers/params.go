@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+)
+
+// parseIdParam reads the {id} URL param as a number
+// parseIdParam lee el parámetro de URL {id} como número
+func parseIdParam(r *http.Request) (int, error) {
+	value := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: id %q must be a number", error_message.ErrBadRequest, value)
+	}
+	return id, nil
+}
+
+// parseIdQueryParam reads an optional positive id filter from the query string; absent means 0 (no filter)
+// parseIdQueryParam lee un filtro opcional de id positivo del query string; ausente significa 0 (sin filtro)
+func parseIdQueryParam(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("%w: invalid %s %q: must be a positive number", error_message.ErrBadRequest, name, value)
+	}
+	return id, nil
+}
+
+// decodeJSON decodes the request body into v, reporting malformed bodies as bad requests
+// decodeJSON decodifica el cuerpo de la solicitud en v, reportando los cuerpos mal formados como solicitudes inválidas
+func decodeJSON(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: invalid JSON body: %v", error_message.ErrBadRequest, err)
+	}
+	return nil
+}
@@ -1,13 +1,12 @@
 package handlers
 
 import (
-	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/response"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
@@ -56,51 +55,56 @@ func (h *ProductBatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 		responseJson *responses.DataResponse       = &responses.DataResponse{}
 	)
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	if reqErr := json.NewDecoder(r.Body).Decode(request); reqErr != nil {
-		response.Error(w, http.StatusExpectationFailed, reqErr.Error())
+	if reqErr := decodeJSON(r, request); reqErr != nil {
+		writeError(ctx, w, reqErr)
 		return
 	}
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if valErr := h.validation.ValidateProductBatchRequestStruc(*request); valErr != nil {
-		response.Error(w, http.StatusUnprocessableEntity, valErr.Error())
+		writeError(ctx, w, valErr)
 		return
 	}
 
 	// Validate that section exists / Validar que la sección exista
-	if !h.sectionService.ExistWithID(ctx, request.SectionID) {
-		response.Error(w, http.StatusNotFound, "section not found")
+	section, srvErr := h.sectionService.GetByID(ctx, request.SectionID)
+	if srvErr != nil {
+		writeError(ctx, w, srvErr)
 		return
 	}
 
 	// Validate that product exists / Validar que el producto exista
-	exists, _ := h.productService.ExistById(ctx, int64(request.ProductID))
-	if !exists {
-		response.Error(w, http.StatusNotFound, "product not found")
+	product, srvErr := h.productService.GetByID(ctx, int64(request.ProductID))
+	if srvErr != nil {
+		writeError(ctx, w, srvErr)
 		return
 	}
 
 	// Map request to product batch model / Mapear solicitud a modelo de lote de productos
 	productBatch, mapErr := mappers.GetProductBatchModelFromRequest(request)
 	if mapErr != nil {
-		response.Error(w, http.StatusExpectationFailed, mapErr.Error())
+		writeError(ctx, w, fmt.Errorf("%w: %v", error_message.ErrInvalidInput, mapErr))
 		return
 	}
 
 	// Validate batch number uniqueness / Validar unicidad del número de lote
 	if h.service.ExistsWithBatchNumber(ctx, productBatch.Id, productBatch.BatchNumber) {
-		response.Error(w, http.StatusConflict, "already exist a batch with the same number")
+		writeError(ctx, w, fmt.Errorf("%w: already exist a batch with the same number", error_message.ErrAlreadyExists))
+		return
+	}
+
+	// Validate the batch fits in the section volume and weight limits / Validar que el lote entre en los límites de volumen y peso de la sección
+	if srvErr := h.service.ValidatePlacement(ctx, section, product, productBatch.CurrentQuantity); srvErr != nil {
+		writeError(ctx, w, srvErr)
 		return
 	}
 
 	// Create product batch through service layer / Crear lote de productos a través de la capa de servicio
 	if srvErr := h.service.Create(ctx, productBatch); srvErr != nil {
-		response.Error(w, http.StatusExpectationFailed, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
@@ -116,9 +120,7 @@ func (h *ProductBatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 func (h *ProductBatchHandler) GetReportProduct(w http.ResponseWriter, r *http.Request) {
 	var responseJson *responses.DataResponse = &responses.DataResponse{}
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Get and validate optional section ID query parameter / Obtener y validar parámetro opcional de consulta ID de sección
 	idParamString := r.URL.Query().Get("id")
@@ -126,42 +128,42 @@ func (h *ProductBatchHandler) GetReportProduct(w http.ResponseWriter, r *http.Re
 		// Parse and validate section ID / Parsear y validar ID de sección
 		idParam, convErr := strconv.Atoi(idParamString)
 		if convErr != nil {
-			response.Error(w, http.StatusExpectationFailed, convErr.Error())
+			writeError(ctx, w, fmt.Errorf("%w: id %q must be a number", error_message.ErrBadRequest, idParamString))
 			return
 		}
 
 		// Get section by ID to validate existence / Obtener sección por ID para validar existencia
 		section, srvErr := h.sectionService.GetByID(ctx, idParam)
 		if srvErr != nil {
-			response.Error(w, http.StatusNotFound, srvErr.Error())
+			writeError(ctx, w, srvErr)
 			return
 		}
 
 		// Get product quantity for specific section / Obtener cantidad de productos para sección específica
 		quantity := h.service.GetProductQuantityBySectionId(ctx, section.Id)
-		responseJson.Data = map[string]any{
-			"section_id":     section.Id,
-			"section_number": section.SectionNumber,
-			"products_count": quantity,
+		responseJson.Data = responses.SectionProductsReportResponse{
+			SectionId:     section.Id,
+			SectionNumber: section.SectionNumber,
+			ProductsCount: quantity,
 		}
 		response.JSON(w, http.StatusCreated, responseJson)
 
 	} else {
 		// Get reports for all sections / Obtener reportes para todas las secciones
 		sections, srvErr := h.sectionService.GetAll(ctx)
-		data := make([]map[string]any, 0, len(sections))
+		data := make([]responses.SectionProductsReportResponse, 0, len(sections))
 		if srvErr != nil {
-			response.Error(w, http.StatusExpectationFailed, srvErr.Error())
+			writeError(ctx, w, srvErr)
 			return
 		}
 
 		// Build report data for each section / Construir datos de reporte para cada sección
 		for _, s := range sections {
 			quantity := h.service.GetProductQuantityBySectionId(ctx, s.Id)
-			data = append(data, map[string]any{
-				"section_id":     s.Id,
-				"section_number": s.SectionNumber,
-				"products_count": quantity,
+			data = append(data, responses.SectionProductsReportResponse{
+				SectionId:     s.Id,
+				SectionNumber: s.SectionNumber,
+				ProductsCount: quantity,
 			})
 		}
 		responseJson.Data = data
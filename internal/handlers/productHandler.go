@@ -1,12 +1,9 @@
 package handlers
 
 import (
-	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
-	"time" // Import the time package
 
 	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
@@ -36,24 +33,13 @@ func NewProductHandler(service services.ProductService) *ProductHandler {
 // GetAll maneja las solicitudes GET para obtener todos los productos
 // GetAll handles GET requests to retrieve all products
 func (ph *ProductHandler) GetAll(w http.ResponseWriter, r *http.Request) {
-	// Establece timeout de 3 segundos para la operación
-	// Set a 3-second timeout for the operation
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Obtiene todos los productos del servicio
 	// Get all products from the service
 	products, err := ph.service.GetAll(ctx)
 	if err != nil {
-		// Manejo de timeout
-		// Handle timeout
-		if errors.Is(err, context.DeadlineExceeded) {
-			response.Error(w, http.StatusGatewayTimeout, "the request took too long to process")
-			return
-		}
-		// Manejo de errores generales
-		// Handle general errors
-		response.Error(w, http.StatusInternalServerError, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -65,16 +51,13 @@ func (ph *ProductHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 // Create maneja las solicitudes POST para crear un nuevo producto
 // Create handles POST requests to create a new product
 func (ph *ProductHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// Establece timeout de 3 segundos para la operación
-	// Set a 3-second timeout for the operation
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Decodifica el JSON del request
 	// Decode JSON from request
 	var productRequest requests.ProductRequest
 	if err := request.JSON(r, &productRequest); err != nil {
-		response.Error(w, http.StatusBadRequest, "Invalid request payload: "+err.Error())
+		writeError(ctx, w, fmt.Errorf("%w: %v", error_message.ErrBadRequest, err))
 		return
 	}
 
@@ -82,7 +65,7 @@ func (ph *ProductHandler) Create(w http.ResponseWriter, r *http.Request) {
 	// Validate request structure
 	validation := validations.GetProductValidation()
 	if err := validation.ValidateProductRequestStruct(productRequest); err != nil {
-		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -94,21 +77,7 @@ func (ph *ProductHandler) Create(w http.ResponseWriter, r *http.Request) {
 	// Create product through service
 	newProduct, err := ph.service.Create(ctx, product)
 	if err != nil {
-		// Manejo de timeout
-		// Handle timeout
-		if errors.Is(err, context.DeadlineExceeded) {
-			response.Error(w, http.StatusGatewayTimeout, "the request took too long to process")
-			return
-		}
-		// Manejo de conflictos (producto ya existe)
-		// Handle conflicts (product already exists)
-		if errors.Is(err, error_message.ErrAlreadyExists) {
-			response.Error(w, http.StatusConflict, err.Error())
-			return
-		}
-		// Manejo de errores generales
-		// Handle general errors
-		response.Error(w, http.StatusInternalServerError, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -121,16 +90,13 @@ func (ph *ProductHandler) Create(w http.ResponseWriter, r *http.Request) {
 // Get maneja las solicitudes GET para obtener un producto específico por ID
 // Get handles GET requests to retrieve a specific product by ID
 func (ph *ProductHandler) Get(w http.ResponseWriter, r *http.Request) {
-	// Establece timeout de 3 segundos para la operación
-	// Set a 3-second timeout for the operation
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Extrae y valida el ID del parámetro de URL
 	// Extract and validate ID from URL parameter
 	id, err := parseID(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -138,21 +104,7 @@ func (ph *ProductHandler) Get(w http.ResponseWriter, r *http.Request) {
 	// Get product by ID from service
 	product, err := ph.service.GetByID(ctx, id)
 	if err != nil {
-		// Manejo de timeout
-		// Handle timeout
-		if errors.Is(err, context.DeadlineExceeded) {
-			response.Error(w, http.StatusGatewayTimeout, "the request took too long to process")
-			return
-		}
-		// Manejo de producto no encontrado
-		// Handle product not found
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		// Manejo de errores generales
-		// Handle general errors
-		response.Error(w, http.StatusInternalServerError, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -165,16 +117,13 @@ func (ph *ProductHandler) Get(w http.ResponseWriter, r *http.Request) {
 // Update maneja las solicitudes PUT para actualizar un producto existente
 // Update handles PUT requests to update an existing product
 func (ph *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
-	// Establece timeout de 3 segundos para la operación
-	// Set a 3-second timeout for the operation
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Extrae y valida el ID del parámetro de URL
 	// Extract and validate ID from URL parameter
 	id, err := parseID(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -182,7 +131,7 @@ func (ph *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
 	// Decode JSON from request
 	var productRequest requests.ProductRequest
 	if err := request.JSON(r, &productRequest); err != nil {
-		response.Error(w, http.StatusBadRequest, "Invalid request payload: "+err.Error())
+		writeError(ctx, w, fmt.Errorf("%w: %v", error_message.ErrBadRequest, err))
 		return
 	}
 
@@ -194,21 +143,7 @@ func (ph *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
 	// Update product through service
 	updatedProduct, err := ph.service.Update(ctx, id, productToUpdate)
 	if err != nil {
-		// Manejo de timeout
-		// Handle timeout
-		if errors.Is(err, context.DeadlineExceeded) {
-			response.Error(w, http.StatusGatewayTimeout, "the request took too long to process")
-			return
-		}
-		// Manejo de producto no encontrado
-		// Handle product not found
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		// Manejo de errores generales
-		// Handle general errors
-		response.Error(w, http.StatusInternalServerError, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -221,37 +156,20 @@ func (ph *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
 // Delete maneja las solicitudes DELETE para eliminar un producto
 // Delete handles DELETE requests to remove a product
 func (ph *ProductHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	// Establece timeout de 3 segundos para la operación
-	// Set a 3-second timeout for the operation
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Extrae y valida el ID del parámetro de URL
 	// Extract and validate ID from URL parameter
 	id, err := parseID(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Elimina el producto a través del servicio
 	// Delete product through service
 	if err := ph.service.Delete(ctx, id); err != nil {
-		// Manejo de timeout
-		// Handle timeout
-		if errors.Is(err, context.DeadlineExceeded) {
-			response.Error(w, http.StatusGatewayTimeout, "the request took too long to process")
-			return
-		}
-		// Manejo de producto no encontrado
-		// Handle product not found
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		// Manejo de errores generales
-		// Handle general errors
-		response.Error(w, http.StatusInternalServerError, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -265,11 +183,11 @@ func (ph *ProductHandler) Delete(w http.ResponseWriter, r *http.Request) {
 func parseID(r *http.Request) (int64, error) {
 	idStr := chi.URLParam(r, "id")
 	if idStr == "" {
-		return 0, errors.New("id parameter is required")
+		return 0, fmt.Errorf("%w: id parameter is required", error_message.ErrBadRequest)
 	}
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return 0, fmt.Errorf("invalid id parameter: '%s' is not a valid number", idStr)
+		return 0, fmt.Errorf("%w: invalid id parameter: '%s' is not a valid number", error_message.ErrBadRequest, idStr)
 	}
 	return id, nil
 }
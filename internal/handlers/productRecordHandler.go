@@ -1,12 +1,10 @@
 package handlers
 
 import (
-	"context"
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
@@ -46,10 +44,7 @@ func NewProductRecordHandler(service services.ProductRecordServiceI) ProductReco
 // Create - HTTP handler for creating product records with full request processing pipeline
 // Create - Manejador HTTP para crear registros de productos con pipeline completo de procesamiento de peticiones
 func (prh *productRecordHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// CONTEXT MANAGEMENT: Create context with timeout for request processing
-	// GESTIÓN DE CONTEXTO: Crear contexto con timeout para procesamiento de peticiones
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel() // Ensure context is cancelled to prevent resource leaks / Asegurar que el contexto se cancele para prevenir fugas de recursos
+	ctx := r.Context()
 
 	var productRecordRequest requests.ProductRecordRequest
 
@@ -57,7 +52,7 @@ func (prh *productRecordHandler) Create(w http.ResponseWriter, r *http.Request)
 	// ANÁLISIS DE PETICIÓN: Analizar el cuerpo de la petición JSON en datos estructurados
 	err := request.JSON(r, &productRecordRequest)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, fmt.Errorf("%w: %v", error_message.ErrBadRequest, err))
 		return
 	}
 
@@ -66,7 +61,7 @@ func (prh *productRecordHandler) Create(w http.ResponseWriter, r *http.Request)
 	v := validations.GetProductRecordValidation()
 	err = v.ValidateProductRecordRequestStruct(productRecordRequest)
 	if err != nil {
-		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -79,13 +74,7 @@ func (prh *productRecordHandler) Create(w http.ResponseWriter, r *http.Request)
 	result, err := prh.Service.CreateProductRecord(ctx, productRecord)
 
 	if err != nil {
-		// ERROR MAPPING: Map business errors to appropriate HTTP status codes
-		// MAPEO DE ERRORES: Mapear errores de negocio a códigos de estado HTTP apropiados
-		if errors.Is(err, error_message.ErrDependencyNotFound) {
-			response.Error(w, http.StatusConflict, err.Error())
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -100,10 +89,7 @@ func (prh *productRecordHandler) Create(w http.ResponseWriter, r *http.Request)
 // GetReport - HTTP handler for retrieving product record reports with URL parameter processing
 // GetReport - Manejador HTTP para obtener reportes de registros de productos con procesamiento de parámetros URL
 func (prh *productRecordHandler) GetReport(w http.ResponseWriter, r *http.Request) {
-	// CONTEXT MANAGEMENT: Create context with longer timeout for report generation
-	// GESTIÓN DE CONTEXTO: Crear contexto con timeout más largo para generación de reportes
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel() // Ensure context is cancelled to prevent resource leaks / Asegurar que el contexto se cancele para prevenir fugas de recursos
+	ctx := r.Context()
 
 	// URL PARAMETER EXTRACTION: Extract and validate ID parameter from query string
 	// EXTRACCIÓN DE PARÁMETROS URL: Extraer y validar parámetro ID del query string
@@ -112,7 +98,7 @@ func (prh *productRecordHandler) GetReport(w http.ResponseWriter, r *http.Reques
 	if idString == "" {
 		report, err := prh.Service.GetReport(ctx)
 		if err != nil {
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -124,7 +110,7 @@ func (prh *productRecordHandler) GetReport(w http.ResponseWriter, r *http.Reques
 		// SANITIZACIÓN DE ENTRADA: Convertir ID string a entero con validación
 		idInt, err := strconv.Atoi(idString)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, "error: id not is a number")
+			writeError(ctx, w, fmt.Errorf("%w: id %q must be a number", error_message.ErrBadRequest, idString))
 			return
 		}
 
@@ -133,13 +119,7 @@ func (prh *productRecordHandler) GetReport(w http.ResponseWriter, r *http.Reques
 		productRecordReport, err := prh.Service.GetReportByIdProduct(ctx, int64(idInt))
 
 		if err != nil {
-			// ERROR MAPPING: Map service layer errors to HTTP status codes
-			// MAPEO DE ERRORES: Mapear errores de capa de servicio a códigos de estado HTTP
-			if errors.Is(err, error_message.ErrDependencyNotFound) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/services"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/validations"
+)
+
+// ProductTypeHandler handles HTTP requests for product type operations
+// ProductTypeHandler maneja las solicitudes HTTP para operaciones de tipos de producto
+type ProductTypeHandler struct {
+	productTypeService services.ProductTypeService // Service layer for product type business logic / Capa de servicio para lógica de negocio de tipos de producto
+}
+
+// NewProductTypeHandler creates and returns a new instance of ProductTypeHandler with the required service
+// NewProductTypeHandler crea y retorna una nueva instancia de ProductTypeHandler con el servicio requerido
+func NewProductTypeHandler(productTypeService services.ProductTypeService) *ProductTypeHandler {
+	return &ProductTypeHandler{productTypeService: productTypeService}
+}
+
+// GetAll handles HTTP GET requests to retrieve all product types
+// GetAll maneja las solicitudes HTTP GET para recuperar todos los tipos de producto
+func (h *ProductTypeHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productTypes, err := h.productTypeService.GetAll(ctx)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	// Map models to response format / Mapear modelos a formato de respuesta
+	productTypeResponses := make([]responses.ProductTypeResponse, 0, len(productTypes))
+	for _, productType := range productTypes {
+		productTypeResponses = append(productTypeResponses, mappers.ToProductTypeResponse(productType))
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: productTypeResponses})
+}
+
+// GetById handles HTTP GET requests to retrieve a product type by ID
+// GetById maneja las solicitudes HTTP GET para recuperar un tipo de producto por ID
+func (h *ProductTypeHandler) GetById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	productType, err := h.productTypeService.GetById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToProductTypeResponse(productType)})
+}
+
+// Create handles HTTP POST requests to create a new product type
+// Create maneja las solicitudes HTTP POST para crear un nuevo tipo de producto
+func (h *ProductTypeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
+	var request requests.ProductTypeRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if err := validations.ValidateProductTypeRequestStruct(request); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	productType, err := h.productTypeService.Create(ctx, mappers.ToProductTypeModel(request))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, responses.DataResponse{Data: mappers.ToProductTypeResponse(productType)})
+}
+
+// Update handles HTTP PATCH requests applying the provided fields over an existing product type
+// Update maneja las solicitudes HTTP PATCH aplicando los campos enviados sobre un tipo de producto existente
+func (h *ProductTypeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
+	var patch requests.ProductTypePatchRequest
+	if err := decodeJSON(r, &patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if err := validations.ValidateProductTypePatchRequest(patch); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	existing, err := h.productTypeService.GetById(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	productType, err := h.productTypeService.Update(ctx, id, mappers.ApplyProductTypePatch(existing, patch))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToProductTypeResponse(productType)})
+}
+
+// Delete handles HTTP DELETE requests to remove a product type that nothing references
+// Delete maneja las solicitudes HTTP DELETE para eliminar un tipo de producto que nada referencia
+func (h *ProductTypeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	if err := h.productTypeService.Delete(ctx, id); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+}
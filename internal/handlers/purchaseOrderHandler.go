@@ -1,11 +1,9 @@
 package handlers
 
 import (
-	"context"
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
@@ -46,9 +44,7 @@ type PurchaseOrderHandler struct {
 // Valida el cuerpo de la solicitud y retorna códigos de estado HTTP apropiados
 func (h *PurchaseOrderHandler) PostPurchaseOrder() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 
 		var (
 			requestResponse *responses.DataResponse       = &responses.DataResponse{}
@@ -58,14 +54,14 @@ func (h *PurchaseOrderHandler) PostPurchaseOrder() http.HandlerFunc {
 		// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
 		err := request.JSON(r, &requestOrder)
 		if err != nil {
-			response.Error(w, http.StatusBadRequest, err.Error())
+			writeError(ctx, w, fmt.Errorf("%w: %v", error_message.ErrBadRequest, err))
 			return
 		}
 
 		// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 		err = validations.ValidatePurchaseOrderRequestStruct(requestOrder)
 		if err != nil {
-			response.Error(w, http.StatusUnprocessableEntity, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -73,18 +69,7 @@ func (h *PurchaseOrderHandler) PostPurchaseOrder() http.HandlerFunc {
 		modelOrder := mappers.GetModelPurchaseOrderFromRequest(requestOrder)
 		orderDb, err := h.service.Create(ctx, *modelOrder)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrAlreadyExists) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -102,9 +87,7 @@ func (h *PurchaseOrderHandler) PostPurchaseOrder() http.HandlerFunc {
 // Retorna una respuesta JSON con todas las órdenes de compra o códigos de error apropiados
 func (h *PurchaseOrderHandler) GetAll() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 		var (
 			requestResponse       *responses.DataResponse = &responses.DataResponse{}
 			purchaseOrderResponse []*responses.PurchaseOrderResponse
@@ -114,7 +97,8 @@ func (h *PurchaseOrderHandler) GetAll() http.HandlerFunc {
 		// Get all purchase orders from service layer / Obtener todas las órdenes de compra de la capa de servicio
 		purchaseOrdersMap, err := h.service.GetAll(ctx)
 		if err != nil {
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
+			return
 		}
 
 		// Convert map to slice and map to response format / Convertir mapa a slice y mapear a formato de respuesta
@@ -132,9 +116,7 @@ func (h *PurchaseOrderHandler) GetAll() http.HandlerFunc {
 // Acepta un parámetro de consulta 'id' opcional para filtrar por ID de comprador
 func (h *PurchaseOrderHandler) GetPurchaseOrdersReport() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
-		defer cancel()
+		ctx := r.Context()
 		var requestResponse *responses.DataResponse = &responses.DataResponse{}
 		var idRequest *int = nil
 
@@ -142,9 +124,9 @@ func (h *PurchaseOrderHandler) GetPurchaseOrdersReport() http.HandlerFunc {
 		idParam := r.URL.Query().Get("id")
 		if idParam != "" {
 			// Parse and validate buyer ID / Parsear y validar ID de comprador
-			id, err := strconv.Atoi(r.URL.Query().Get("id"))
+			id, err := strconv.Atoi(idParam)
 			if err != nil {
-				response.Error(w, http.StatusBadRequest, err.Error())
+				writeError(ctx, w, fmt.Errorf("%w: id %q must be a number", error_message.ErrBadRequest, idParam))
 				return
 			}
 			idRequest = &id
@@ -153,12 +135,7 @@ func (h *PurchaseOrderHandler) GetPurchaseOrdersReport() http.HandlerFunc {
 		// Get purchase order reports from service layer / Obtener reportes de órdenes de compra de la capa de servicio
 		report, err := h.service.GetPurchaseOrdersReport(ctx, idRequest)
 		if err != nil {
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrNotFound) {
-				response.Error(w, http.StatusNotFound, err.Error())
-				return
-			}
-			response.Error(w, http.StatusInternalServerError, err.Error())
+			writeError(ctx, w, err)
 			return
 		}
 
@@ -0,0 +1,19 @@
+package requests
+
+type CountryPatchRequest struct {
+	CountryName *string `json:"country_name,omitempty"`
+}
+
+type ProvincePatchRequest struct {
+	ProvinceName *string `json:"province_name,omitempty"`
+	CountryId    *int    `json:"country_id,omitempty"`
+}
+
+type LocalityPatchRequest struct {
+	LocalityName *string `json:"locality_name,omitempty"`
+	ProvinceId   *int    `json:"province_id,omitempty"`
+}
+
+type GeographyMergeRequest struct {
+	TargetId int `json:"target_id"`
+}
@@ -0,0 +1,15 @@
+package requests
+
+type ProductTypeRequest struct {
+	Description        string  `json:"description"`
+	StorageClass       string  `json:"storage_class"`
+	MinimumTemperature float64 `json:"minimum_temperature"`
+	MaximumTemperature float64 `json:"maximum_temperature"`
+}
+
+type ProductTypePatchRequest struct {
+	Description        *string  `json:"description,omitempty"`
+	StorageClass       *string  `json:"storage_class,omitempty"`
+	MinimumTemperature *float64 `json:"minimum_temperature,omitempty"`
+	MaximumTemperature *float64 `json:"maximum_temperature,omitempty"`
+}
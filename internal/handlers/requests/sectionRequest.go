@@ -1,12 +1,14 @@
 package requests
 
 type SectionRequest struct {
-	SectionNumber      string  `json:"section_number"`
-	CurrentCapacity    int     `json:"current_capacity"`
-	CurrentTemperature float64 `json:"current_temperature"`
-	MaximumCapacity    int     `json:"maximum_capacity"`
-	MinimumCapacity    int     `json:"minimum_capacity"`
-	MinimumTemperature float64 `json:"minimum_temperature"`
-	ProductTypeID      int     `json:"product_type_id"`
-	WarehouseID        int     `json:"warehouse_id"`
+	SectionNumber      string   `json:"section_number"`
+	CurrentCapacity    int      `json:"current_capacity"`
+	CurrentTemperature float64  `json:"current_temperature"`
+	MaximumCapacity    int      `json:"maximum_capacity"`
+	MinimumCapacity    int      `json:"minimum_capacity"`
+	MinimumTemperature float64  `json:"minimum_temperature"`
+	MaximumVolume      *float64 `json:"maximum_volume,omitempty"`
+	MaximumWeight      *float64 `json:"maximum_weight,omitempty"`
+	ProductTypeID      int      `json:"product_type_id"`
+	WarehouseID        int      `json:"warehouse_id"`
 }
@@ -0,0 +1,15 @@
+package responses
+
+// DatabaseStatsResponse mirrors sql.DBStats for monitoring the connection pool
+// DatabaseStatsResponse refleja sql.DBStats para monitorear el pool de conexiones
+type DatabaseStatsResponse struct {
+	MaxOpenConnections int    `json:"max_open_connections"`
+	OpenConnections    int    `json:"open_connections"`
+	InUse              int    `json:"in_use"`
+	Idle               int    `json:"idle"`
+	WaitCount          int64  `json:"wait_count"`
+	WaitDuration       string `json:"wait_duration"`
+	MaxIdleClosed      int64  `json:"max_idle_closed"`
+	MaxIdleTimeClosed  int64  `json:"max_idle_time_closed"`
+	MaxLifetimeClosed  int64  `json:"max_lifetime_closed"`
+}
@@ -0,0 +1,11 @@
+package responses
+
+type DeleteImpactResponse struct {
+	ID             int  `json:"id"`
+	Sections       int  `json:"sections"`
+	ProductBatches int  `json:"product_batches"`
+	Employees      int  `json:"employees"`
+	InboundOrders  int  `json:"inbound_orders"`
+	Total          int  `json:"total"`
+	CanDelete      bool `json:"can_delete"`
+}
@@ -0,0 +1,18 @@
+package responses
+
+// ErrorResponse is the envelope written for every failed request
+// Message is translated by Code, Detail carries the untranslated specifics of the error
+// and Errors is only present on validation failures, keyed by the JSON field name
+// RequestID matches the X-Request-Id header and the request_id of the logs
+// ErrorResponse es el sobre que se escribe en toda solicitud fallida
+// Message se traduce según Code, Detail lleva los datos específicos del error sin traducir
+// y Errors solo aparece en fallas de validación, indexado por el nombre del campo JSON
+// RequestID coincide con el header X-Request-Id y con el request_id de los logs
+type ErrorResponse struct {
+	Status    string            `json:"status"`
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Detail    string            `json:"detail,omitempty"`
+	Errors    map[string]string `json:"errors,omitempty"`
+	RequestID string            `json:"request_id,omitempty"`
+}
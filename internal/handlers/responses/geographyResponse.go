@@ -0,0 +1,55 @@
+package responses
+
+type CountryResponse struct {
+	ID          int    `json:"id"`
+	CountryName string `json:"country_name"`
+}
+
+type ProvinceResponse struct {
+	ID           int    `json:"id"`
+	ProvinceName string `json:"province_name"`
+	CountryID    int    `json:"country_id"`
+	CountryName  string `json:"country_name"`
+}
+
+type LocalityResponse struct {
+	ID           int    `json:"id"`
+	LocalityName string `json:"locality_name"`
+	ProvinceID   int    `json:"province_id"`
+	ProvinceName string `json:"province_name"`
+	CountryID    int    `json:"country_id"`
+	CountryName  string `json:"country_name"`
+}
+
+type CountryNodeResponse struct {
+	ID          int                    `json:"id"`
+	CountryName string                 `json:"country_name"`
+	Provinces   []ProvinceNodeResponse `json:"provinces"`
+}
+
+type ProvinceNodeResponse struct {
+	ID           int                    `json:"id"`
+	ProvinceName string                 `json:"province_name"`
+	Localities   []LocalityNodeResponse `json:"localities"`
+}
+
+type LocalityNodeResponse struct {
+	ID           int    `json:"id"`
+	LocalityName string `json:"locality_name"`
+}
+
+type GeographyMergeResponse struct {
+	SourceID   int `json:"source_id"`
+	TargetID   int `json:"target_id"`
+	Provinces  int `json:"provinces_moved"`
+	Localities int `json:"localities_moved"`
+	Sellers    int `json:"sellers_repointed"`
+	Carriers   int `json:"carriers_repointed"`
+	Warehouses int `json:"warehouses_repointed"`
+}
+
+type LocalitySearchResponse struct {
+	LocalityResponse
+	MatchedOn string `json:"matched_on"`
+	Distance  int    `json:"distance"`
+}
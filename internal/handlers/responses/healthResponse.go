@@ -0,0 +1,21 @@
+package responses
+
+// HealthResponse is the body of the liveness probe / HealthResponse es el cuerpo de la prueba de vida
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// ReadinessResponse reports every readiness check: "ok", "unavailable" or the pending migrations
+// ReadinessResponse reporta cada chequeo de disponibilidad: "ok", "unavailable" o las migraciones pendientes
+type ReadinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// VersionResponse describes the running build / VersionResponse describe el build en ejecución
+type VersionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
@@ -0,0 +1,15 @@
+package responses
+
+type LocalityDashboardResponse struct {
+	Level           string `json:"level"`
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	ProvinceID      int    `json:"province_id,omitempty"`
+	ProvinceName    string `json:"province_name,omitempty"`
+	CountryID       int    `json:"country_id,omitempty"`
+	CountryName     string `json:"country_name,omitempty"`
+	LocalitiesCount int    `json:"localities_count"`
+	SellersCount    int    `json:"sellers_count"`
+	CarriersCount   int    `json:"carriers_count"`
+	WarehousesCount int    `json:"warehouses_count"`
+}
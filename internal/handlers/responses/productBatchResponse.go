@@ -13,3 +13,11 @@ type ProductBatchResponse struct {
 	ProductID          int     `json:"product_id"`
 	SectionID          int     `json:"section_id"`
 }
+
+// SectionProductsReportResponse - Amount of products stored in a section
+// SectionProductsReportResponse - Cantidad de productos guardados en una sección
+type SectionProductsReportResponse struct {
+	SectionId     int    `json:"section_id"`
+	SectionNumber string `json:"section_number"`
+	ProductsCount int    `json:"products_count"`
+}
@@ -0,0 +1,9 @@
+package responses
+
+type ProductTypeResponse struct {
+	ID                 int     `json:"id"`
+	Description        string  `json:"description"`
+	StorageClass       string  `json:"storage_class"`
+	MinimumTemperature float64 `json:"minimum_temperature"`
+	MaximumTemperature float64 `json:"maximum_temperature"`
+}
@@ -0,0 +1,12 @@
+package responses
+
+type SellerReportResponse struct {
+	SellerID      int     `json:"seller_id"`
+	CompanyName   string  `json:"company_name"`
+	ProductsCount int     `json:"products_count"`
+	BatchStock    int     `json:"batch_stock"`
+	UnitsSold     int     `json:"units_sold"`
+	Revenue       float64 `json:"revenue"`
+	From          *string `json:"from,omitempty"`
+	To            *string `json:"to,omitempty"`
+}
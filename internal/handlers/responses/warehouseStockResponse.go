@@ -0,0 +1,36 @@
+package responses
+
+type WarehouseStockResponse struct {
+	WarehouseID     int                    `json:"warehouse_id"`
+	WarehouseCode   string                 `json:"warehouse_code"`
+	TotalQuantity   int                    `json:"total_quantity"`
+	EarliestDueDate string                 `json:"earliest_due_date,omitempty"`
+	Sections        []SectionStockResponse `json:"sections"`
+}
+
+type SectionStockResponse struct {
+	SectionID             int                    `json:"section_id"`
+	SectionNumber         string                 `json:"section_number"`
+	MaximumCapacity       int                    `json:"maximum_capacity"`
+	MaximumVolume         *float64               `json:"maximum_volume,omitempty"`
+	MaximumWeight         *float64               `json:"maximum_weight,omitempty"`
+	TotalQuantity         int                    `json:"total_quantity"`
+	TotalVolume           float64                `json:"total_volume"`
+	TotalWeight           float64                `json:"total_weight"`
+	UtilizationPercentage float64                `json:"utilization_percentage"`
+	VolumeUtilization     *float64               `json:"volume_utilization_percentage,omitempty"`
+	WeightUtilization     *float64               `json:"weight_utilization_percentage,omitempty"`
+	EarliestDueDate       string                 `json:"earliest_due_date,omitempty"`
+	Products              []ProductStockResponse `json:"products"`
+}
+
+type ProductStockResponse struct {
+	ProductID       int     `json:"product_id"`
+	ProductCode     string  `json:"product_code"`
+	Description     string  `json:"description"`
+	BatchesCount    int     `json:"batches_count"`
+	TotalQuantity   int     `json:"total_quantity"`
+	TotalVolume     float64 `json:"total_volume"`
+	TotalWeight     float64 `json:"total_weight"`
+	EarliestDueDate string  `json:"earliest_due_date,omitempty"`
+}
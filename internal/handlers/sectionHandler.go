@@ -2,13 +2,11 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
-	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/response"
-	"github.com/go-chi/chi/v5"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
@@ -19,11 +17,12 @@ import (
 
 // GetSectionHandler creates and returns a new instance of SectionHandler with required services and validation
 // GetSectionHandler crea y retorna una nueva instancia de SectionHandler con los servicios y validación requeridos
-func GetSectionHandler(service services.SectionServiceI, warehouseService services.WarehouseService, validation *validations.SectionValidation) SectionHandlerI {
+func GetSectionHandler(service services.SectionServiceI, warehouseService services.WarehouseService, productTypeService services.ProductTypeService, validation *validations.SectionValidation) SectionHandlerI {
 	return &SectionHandler{
-		service:          service,
-		warehouseService: warehouseService,
-		validation:       validation,
+		service:            service,
+		warehouseService:   warehouseService,
+		productTypeService: productTypeService,
+		validation:         validation,
 	}
 }
 
@@ -40,9 +39,10 @@ type SectionHandlerI interface {
 // SectionHandler implements SectionHandlerI and handles HTTP requests for section operations
 // SectionHandler implementa SectionHandlerI y maneja las solicitudes HTTP para operaciones de secciones
 type SectionHandler struct {
-	service          services.SectionServiceI       // Service layer for section business logic / Capa de servicio para lógica de negocio de secciones
-	warehouseService services.WarehouseService      // Service layer for warehouse validation / Capa de servicio para validación de almacenes
-	validation       *validations.SectionValidation // Validation layer for section requests / Capa de validación para solicitudes de secciones
+	service            services.SectionServiceI       // Service layer for section business logic / Capa de servicio para lógica de negocio de secciones
+	warehouseService   services.WarehouseService      // Service layer for warehouse validation / Capa de servicio para validación de almacenes
+	productTypeService services.ProductTypeService    // Service layer for product type storage rules / Capa de servicio para reglas de almacenamiento de tipos de producto
+	validation         *validations.SectionValidation // Validation layer for section requests / Capa de validación para solicitudes de secciones
 }
 
 // GetAll handles HTTP GET requests to retrieve all sections
@@ -52,14 +52,12 @@ type SectionHandler struct {
 func (h *SectionHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	var responseJson *responses.DataResponse = &responses.DataResponse{}
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Get all sections from service layer / Obtener todas las secciones de la capa de servicio
 	sections, srvErr := h.service.GetAll(ctx)
 	if srvErr != nil {
-		response.Error(w, http.StatusNotFound, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
@@ -75,21 +73,19 @@ func (h *SectionHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 func (h *SectionHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	var responseJson *responses.DataResponse = &responses.DataResponse{}
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	idParam, convErr := strconv.Atoi(chi.URLParam(r, "id"))
+	idParam, convErr := parseIdParam(r)
 	if convErr != nil {
-		response.Error(w, http.StatusExpectationFailed, convErr.Error())
+		writeError(ctx, w, convErr)
 		return
 	}
 
 	// Get section by ID from service layer / Obtener sección por ID de la capa de servicio
 	section, srvErr := h.service.GetByID(ctx, idParam)
 	if srvErr != nil {
-		response.Error(w, http.StatusNotFound, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
@@ -109,41 +105,44 @@ func (h *SectionHandler) Create(w http.ResponseWriter, r *http.Request) {
 		section      *models.Section
 	)
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	if reqErr := json.NewDecoder(r.Body).Decode(request); reqErr != nil {
-		response.Error(w, http.StatusExpectationFailed, reqErr.Error())
+	if reqErr := decodeJSON(r, request); reqErr != nil {
+		writeError(ctx, w, reqErr)
 		return
 	}
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if valErr := h.validation.ValidateSectionRequestStruct(*request); valErr != nil {
-		response.Error(w, http.StatusUnprocessableEntity, valErr.Error())
+		writeError(ctx, w, valErr)
 		return
 	}
 
 	// Validate that warehouse exists / Validar que el almacén exista
 	_, srvErr := h.warehouseService.GetById(ctx, request.WarehouseID)
 	if srvErr != nil {
-		response.Error(w, http.StatusNotFound, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
 	// Map request to section model / Mapear solicitud a modelo de sección
 	section = mappers.GetSectionModelFromRequest(request)
 
+	// Validate the temperature against the product type storage range / Validar la temperatura contra el rango de almacenamiento del tipo de producto
+	if !h.validateStorage(ctx, w, section) {
+		return
+	}
+
 	// Validate section number uniqueness / Validar unicidad del número de sección
 	if h.service.ExistsWithSectionNumber(ctx, section.Id, section.SectionNumber) {
-		response.Error(w, http.StatusConflict, "already exist a section with the same number")
+		writeError(ctx, w, fmt.Errorf("%w: already exist a section with the same number", error_message.ErrAlreadyExists))
 		return
 	}
 
 	// Create section through service layer / Crear sección a través de la capa de servicio
 	if srvErr := h.service.Create(ctx, section); srvErr != nil {
-		response.Error(w, http.StatusExpectationFailed, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
@@ -162,46 +161,50 @@ func (h *SectionHandler) Update(w http.ResponseWriter, r *http.Request) {
 		responseJson *responses.DataResponse  = &responses.DataResponse{}
 	)
 
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	idParam, convErr := strconv.Atoi(chi.URLParam(r, "id"))
+	idParam, convErr := parseIdParam(r)
 	if convErr != nil {
-		response.Error(w, http.StatusExpectationFailed, convErr.Error())
+		writeError(ctx, w, convErr)
 		return
 	}
 
 	// Get existing section by ID / Obtener sección existente por ID
 	section, srvErr := h.service.GetByID(ctx, idParam)
 	if srvErr != nil {
-		response.Error(w, http.StatusNotFound, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	if reqErr := json.NewDecoder(r.Body).Decode(request); reqErr != nil {
-		response.Error(w, http.StatusExpectationFailed, reqErr.Error())
+	if reqErr := decodeJSON(r, request); reqErr != nil {
+		writeError(ctx, w, reqErr)
 		return
 	}
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if valErr := h.validation.ValidateSectionRequestStruct(*request); valErr != nil {
-		response.Error(w, http.StatusUnprocessableEntity, valErr.Error())
+		writeError(ctx, w, valErr)
 		return
 	}
 
 	// Validate section number uniqueness for update / Validar unicidad del número de sección para actualización
 	if h.service.ExistsWithSectionNumber(ctx, section.Id, request.SectionNumber) {
-		response.Error(w, http.StatusConflict, "already exist a section with the same number")
+		writeError(ctx, w, fmt.Errorf("%w: already exist a section with the same number", error_message.ErrAlreadyExists))
 		return
 	}
 
 	// Update section model with request data / Actualizar modelo de sección con datos de la solicitud
 	mappers.UpdateSectionModelFromRequest(section, request)
+
+	// Validate the temperature against the product type storage range / Validar la temperatura contra el rango de almacenamiento del tipo de producto
+	if !h.validateStorage(ctx, w, section) {
+		return
+	}
+
 	if srvErr := h.service.Update(ctx, section); srvErr != nil {
-		response.Error(w, http.StatusExpectationFailed, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
@@ -211,27 +214,53 @@ func (h *SectionHandler) Update(w http.ResponseWriter, r *http.Request) {
 }
 
 // DeleteByID handles HTTP DELETE requests to remove a section by ID
-// Extracts the ID from the URL parameter and deletes the section
+// Supports ?dryRun=true to preview dependents and ?force=true to delete them in cascade
 // DeleteByID maneja las solicitudes HTTP DELETE para eliminar una sección por ID
-// Extrae el ID del parámetro de URL y elimina la sección
+// Soporta ?dryRun=true para ver los dependientes y ?force=true para borrarlos en cascada
 func (h *SectionHandler) DeleteByID(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	idParam, convErr := strconv.Atoi(chi.URLParam(r, "id"))
+	idParam, convErr := parseIdParam(r)
 	if convErr != nil {
-		response.Error(w, http.StatusExpectationFailed, convErr.Error())
+		writeError(ctx, w, convErr)
+		return
+	}
+
+	// Parse dryRun and force flags / Parsear flags dryRun y force
+	opts, optsErr := parseDeleteOptions(r)
+	if optsErr != nil {
+		writeError(ctx, w, optsErr)
+		return
+	}
+
+	// On dry run only report the dependent rows / En dry run solo reportar las filas dependientes
+	if opts.DryRun {
+		impact, srvErr := h.service.DeleteImpact(ctx, idParam)
+		if srvErr != nil {
+			writeError(ctx, w, srvErr)
+			return
+		}
+		response.JSON(w, http.StatusOK, &responses.DataResponse{Data: mappers.GetDeleteImpactResponseFromModel(idParam, impact)})
 		return
 	}
 
 	// Delete section through service layer / Eliminar sección a través de la capa de servicio
-	srvErr := h.service.DeleteByID(ctx, idParam)
+	srvErr := h.service.DeleteByID(ctx, idParam, opts.Force)
 	if srvErr != nil {
-		response.Error(w, http.StatusNotFound, srvErr.Error())
+		writeError(ctx, w, srvErr)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// validateStorage checks the section temperature against its product type and writes the error response when it fails
+// validateStorage verifica la temperatura de la sección contra su tipo de producto y escribe la respuesta de error si falla
+func (h *SectionHandler) validateStorage(ctx context.Context, w http.ResponseWriter, section *models.Section) bool {
+	if err := h.productTypeService.ValidateStorage(ctx, section.ProductTypeID, section.CurrentTemperature); err != nil {
+		writeError(ctx, w, err)
+		return false
+	}
+	return true
+}
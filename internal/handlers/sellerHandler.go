@@ -1,12 +1,12 @@
 package handlers
 
 import (
-	"encoding/json"
+	"fmt"
 	"net/http"
-	"strconv"
+	"time"
 
 	"github.com/bootcamp-go/web/response"
-	"github.com/go-chi/chi/v5"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/mappers"
@@ -32,14 +32,22 @@ func NewSellerHandler(service services.SellerService) *SellerHandler {
 // GetAll maneja las solicitudes HTTP GET para recuperar todos los vendedores
 // Retorna una respuesta JSON con todos los vendedores o códigos de error apropiados
 func (h *SellerHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	// Get all sellers from service layer / Obtener todos los vendedores de la capa de servicio
-	sellers, err := h.service.GetAll()
+	sellers, err := h.service.GetAll(ctx)
 	if err != nil {
-		response.Error(w, http.StatusNotFound, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, responses.DataResponse{Data: sellers})
+	// Map models to response format / Mapear modelos a formato de respuesta
+	sellerResponses := make([]responses.SellerResponse, 0, len(sellers))
+	for _, seller := range sellers {
+		sellerResponses = append(sellerResponses, mappers.ToSellerStructToResponse(seller))
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: sellerResponses})
 }
 
 // GetById handles HTTP GET requests to retrieve a seller by ID
@@ -47,24 +55,23 @@ func (h *SellerHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 // GetById maneja las solicitudes HTTP GET para recuperar un vendedor por ID
 // Extrae el ID del parámetro de URL y retorna los datos del vendedor o códigos de error apropiados
 func (h *SellerHandler) GetById(w http.ResponseWriter, r *http.Request) {
-	// Extract ID parameter from URL / Extraer parámetro ID de la URL
-	id := chi.URLParam(r, "id")
+	ctx := r.Context()
 
 	// Parse and validate ID parameter / Parsear y validar parámetro ID
-	idFormated, err := strconv.Atoi(id)
+	idFormated, err := parseIdParam(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Get seller by ID from service layer / Obtener vendedor por ID de la capa de servicio
-	seller, err1 := h.service.GetById(idFormated)
-	if err1 != nil {
-		response.Error(w, http.StatusNotFound, err1.Error())
+	seller, err := h.service.GetById(ctx, idFormated)
+	if err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, responses.DataResponse{Data: seller})
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToSellerStructToResponse(seller)})
 }
 
 // Save handles HTTP POST requests to create a new seller
@@ -72,19 +79,19 @@ func (h *SellerHandler) GetById(w http.ResponseWriter, r *http.Request) {
 // Save maneja las solicitudes HTTP POST para crear un nuevo vendedor
 // Valida el cuerpo de la solicitud y retorna códigos de estado HTTP apropiados
 func (h *SellerHandler) Save(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	var sellerToCreate requests.SellerRequest
-	data := r.Body
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	errorBody := json.NewDecoder(data).Decode(&sellerToCreate)
-	if errorBody != nil {
-		response.Error(w, http.StatusBadRequest, errorBody.Error())
+	if err := decodeJSON(r, &sellerToCreate); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if err := validations.ValidateSellerRequestStruct(sellerToCreate); err != nil {
-		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -92,14 +99,14 @@ func (h *SellerHandler) Save(w http.ResponseWriter, r *http.Request) {
 	sellerParced := mappers.ToRequestToSellerStruct(sellerToCreate)
 
 	// Create seller through service layer / Crear vendedor a través de la capa de servicio
-	sellerCreated, err := h.service.Save(sellerParced)
+	sellerCreated, err := h.service.Save(ctx, sellerParced)
 	if err != nil {
-		response.Error(w, http.StatusConflict, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Map model to response format / Mapear modelo a formato de respuesta
-	sellerResponse := mappers.ToSellerStructToResponse(sellerCreated[0])
+	sellerResponse := mappers.ToSellerStructToResponse(sellerCreated)
 
 	response.JSON(w, http.StatusOK, responses.DataResponse{Data: sellerResponse})
 }
@@ -109,38 +116,31 @@ func (h *SellerHandler) Save(w http.ResponseWriter, r *http.Request) {
 // Update maneja las solicitudes HTTP PUT para actualizar un vendedor existente
 // Extrae el ID del parámetro de URL y actualiza los datos del vendedor
 func (h *SellerHandler) Update(w http.ResponseWriter, r *http.Request) {
-	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		response.Error(w, http.StatusBadRequest, "id is required")
-		return
-	}
+	ctx := r.Context()
 
 	// Parse and validate ID parameter / Parsear y validar parámetro ID
-	idFormated, err := strconv.Atoi(id)
+	idFormated, err := parseIdParam(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	data := r.Body
 	var bodyFormated requests.SellerRequest
-	errBody := json.NewDecoder(data).Decode(&bodyFormated)
-	if errBody != nil {
-		response.Error(w, http.StatusBadRequest, errBody.Error())
+	if err := decodeJSON(r, &bodyFormated); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Map request to seller model and update through service / Mapear solicitud a modelo de vendedor y actualizar a través del servicio
 	sellerToUpdate := mappers.ToRequestToSellerStruct(bodyFormated)
-	sellerUpdated, errUpdate := h.service.Update(idFormated, sellerToUpdate)
-	if errUpdate != nil {
-		response.Error(w, http.StatusNotFound, errUpdate.Error())
+	sellerUpdated, err := h.service.Update(ctx, idFormated, sellerToUpdate)
+	if err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
-	response.JSON(w, http.StatusOK, responses.DataResponse{Data: sellerUpdated})
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToSellerStructToResponse(sellerUpdated)})
 }
 
 // Delete handles HTTP DELETE requests to remove a seller by ID
@@ -148,26 +148,91 @@ func (h *SellerHandler) Update(w http.ResponseWriter, r *http.Request) {
 // Delete maneja las solicitudes HTTP DELETE para eliminar un vendedor por ID
 // Extrae el ID del parámetro de URL y elimina el vendedor
 func (h *SellerHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		response.Error(w, http.StatusBadRequest, "id is required")
-		return
-	}
+	ctx := r.Context()
 
 	// Parse and validate ID parameter / Parsear y validar parámetro ID
-	idFormated, err := strconv.Atoi(id)
+	idFormated, err := parseIdParam(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Delete seller through service layer / Eliminar vendedor a través de la capa de servicio
-	errDelete := h.service.Delete(idFormated)
-	if errDelete != nil {
-		response.Error(w, http.StatusNotFound, errDelete.Error())
+	if err := h.service.Delete(ctx, idFormated); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	response.JSON(w, http.StatusNoContent, nil)
 }
+
+// GetProducts handles HTTP GET requests to list the products of a seller
+// GetProducts maneja las solicitudes HTTP GET para listar los productos de un vendedor
+func (h *SellerHandler) GetProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	products, err := h.service.GetProducts(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: products})
+}
+
+// GetReport handles HTTP GET requests for the seller performance report
+// Accepts optional from and to query parameters formatted as YYYY-MM-DD
+// GetReport maneja las solicitudes HTTP GET para el reporte de desempeño del vendedor
+// Acepta los parámetros de consulta opcionales from y to con formato YYYY-MM-DD
+func (h *SellerHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	// Parse the optional date range / Parsear el rango de fechas opcional
+	from, err := parseDateParam(r, "from")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	to, err := parseDateParam(r, "to")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	report, err := h.service.GetReport(ctx, id, from, to)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{Data: mappers.ToSellerReportToResponse(report)})
+}
+
+// parseDateParam reads an optional YYYY-MM-DD query parameter, returning nil when it is absent
+// parseDateParam lee un parámetro de consulta opcional YYYY-MM-DD, retornando nil cuando está ausente
+func parseDateParam(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s date %q, expected YYYY-MM-DD", error_message.ErrBadRequest, name, value)
+	}
+	return &date, nil
+}
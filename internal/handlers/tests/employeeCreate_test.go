@@ -1,63 +1,47 @@
 package tests
 
-/*
 import (
-	"bytes"
-	"encoding/json"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/validations"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
-)
-
-type mockEmployeeService struct{}
 
-func (m *mockEmployeeService) Create(e *models.Employee) error {
-	e.Id = 99
-	return nil
-}
-func (m *mockEmployeeService) GetAll() []*models.Employee                        { return nil }
-func (m *mockEmployeeService) GetById(id int) (*models.Employee, error)          { return nil, nil }
-func (m *mockEmployeeService) DeleteById(id int) error                           { return nil }
-func (m *mockEmployeeService) ExistsWhCardNumber(id int, cardNumber string) bool { return false }
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/container"
+)
 
-func TestCreateEmployee_Success(t *testing.T) {
-	handler := handlers.GetEmployeeHandler(&mockEmployeeService{}, validations.GetEmployeeValidation())
+// TestCreateEmployee covers the happy path and the validation errors of POST /employee
+// TestCreateEmployee cubre el camino feliz y los errores de validación de POST /employee
+func TestCreateEmployee(t *testing.T) {
+	router := newMemoryRouter(t)
+	warehouseId := warehouseFixture(t, router, "WH-01")
 
-	requestPayload := requests.EmployeeRequest{
-		CardNumberID: "EMP123",
-		FirstName:    "Juan",
-		LastName:     "Pérez",
-		WarehouseID:  1,
+	id := send(t, router, http.MethodPost, "/employee/", employeeBody("EMP-1", warehouseId), http.StatusCreated)
+	if id == 0 {
+		t.Fatal("expected the created employee to have an id")
 	}
+	send(t, router, http.MethodGet, fmt.Sprintf("/employee/%d", id), nil, http.StatusOK)
 
-	body, _ := json.Marshal(requestPayload)
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/employees", bytes.NewBuffer(body))
-	req.Header.Set("Content-Type", "application/json")
-
-	rr := httptest.NewRecorder()
-
-	handler.Create().ServeHTTP(rr, req)
-
-	if rr.Code != http.StatusCreated {
-		t.Errorf("expected status 201 Created, got %d", rr.Code)
-	}
+	send(t, router, http.MethodPost, "/employee/", employeeBody("EMP-1", warehouseId), http.StatusConflict)
+	send(t, router, http.MethodPost, "/employee/", employeeBody("", warehouseId), http.StatusUnprocessableEntity)
 }
 
-func TestCreateEmployee_InvalidJSON(t *testing.T) {
-	handler := handlers.GetEmployeeHandler(&mockEmployeeService{}, validations.GetEmployeeValidation())
-
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/employees", bytes.NewBuffer([]byte(`invalid-json`)))
-	req.Header.Set("Content-Type", "application/json")
-
-	rr := httptest.NewRecorder()
-	handler.Create().ServeHTTP(rr, req)
+// TestCreateEmployeeMalformedBody calls the handler without the validator in front and still expects a 400
+// TestCreateEmployeeMalformedBody llama al handler sin el validador delante y aun así espera un 400
+func TestCreateEmployeeMalformedBody(t *testing.T) {
+	c, err := container.NewContainer(container.StorageMemory, nil)
+	if err != nil {
+		t.Fatalf("building memory container: %v", err)
+	}
 
-	if rr.Code != http.StatusExpectationFailed {
-		t.Errorf("expected 417 ExpectationFailed, got %d", rr.Code)
+	for name, handler := range map[string]http.HandlerFunc{
+		"employee": c.EmployeeHandler.PostEmployee(),
+		"buyer":    c.BuyerHandler.PostBuyer(),
+	} {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"first_name":`)))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected %d, got %d: %s", name, http.StatusBadRequest, rec.Code, rec.Body.String())
+		}
 	}
 }
-*/
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/migrations"
+)
+
+type pendingMigrations struct {
+	pending []migrations.Migration
+	err     error
+}
+
+func (p pendingMigrations) Pending(context.Context) ([]migrations.Migration, error) {
+	return p.pending, p.err
+}
+
+// TestReadyz reports each check without leaking the underlying error
+// TestReadyz reporta cada chequeo sin exponer el error subyacente
+func TestReadyz(t *testing.T) {
+	cases := []struct {
+		name     string
+		migrator pendingMigrations
+		status   int
+		body     string
+	}{
+		{"ready", pendingMigrations{}, http.StatusOK, `"migrations":"ok"`},
+		{"pending", pendingMigrations{pending: []migrations.Migration{{Version: 3, Name: "geography_names"}}}, http.StatusServiceUnavailable, `"migrations":"1 pending, first is 0003_geography_names"`},
+		{"failing", pendingMigrations{err: errors.New("dial tcp 10.0.0.5:3306: access denied for user 'meli'")}, http.StatusServiceUnavailable, `"migrations":"unavailable"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handlers.NewHealthHandler(nil, tc.migrator).Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			if rec.Code != tc.status || !strings.Contains(rec.Body.String(), tc.body) {
+				t.Errorf("expected %d with %s, got %d: %s", tc.status, tc.body, rec.Code, rec.Body.String())
+			}
+			if strings.Contains(rec.Body.String(), "10.0.0.5") {
+				t.Errorf("the error detail leaked into the response: %s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/container"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/routes"
+)
+
+const apiPrefix = "/api/v1"
+
+// newMemoryRouter serves the whole API over a fresh in-memory store
+// newMemoryRouter sirve toda la API sobre un almacenamiento en memoria nuevo
+func newMemoryRouter(t *testing.T) *chi.Mux {
+	t.Helper()
+	c, err := container.NewContainer(container.StorageMemory, nil)
+	if err != nil {
+		t.Fatalf("building memory container: %v", err)
+	}
+	return routes.SetupRoutes(c, config.RouteTimeouts{})
+}
+
+// send performs a request with an optional JSON body and checks the status code
+// It returns the id of the created or fetched entity when the body has one
+// send ejecuta una solicitud con un cuerpo JSON opcional y verifica el código de estado
+// Retorna el id de la entidad creada u obtenida cuando el cuerpo lo tiene
+func send(t *testing.T, router http.Handler, method, path string, body any, status int) int {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req := httptest.NewRequest(method, apiPrefix+path, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != status {
+		t.Fatalf("%s %s: expected %d, got %d: %s", method, path, status, rec.Code, rec.Body.String())
+	}
+
+	var envelope struct {
+		Data struct {
+			Id int `json:"id"`
+		} `json:"data"`
+	}
+	_ = json.Unmarshal(rec.Body.Bytes(), &envelope)
+	return envelope.Data.Id
+}
+
+// warehouseFixture creates a locality and a warehouse in it, returning the warehouse id
+// warehouseFixture crea una localidad y un almacén en ella, retornando el id del almacén
+func warehouseFixture(t *testing.T, router http.Handler, code string) int {
+	t.Helper()
+	localityId := send(t, router, http.MethodPost, "/localities", map[string]any{
+		"data": map[string]any{"locality_name": "Bogotá", "province_name": "Cundinamarca", "country_name": "Colombia"},
+	}, http.StatusOK)
+
+	return send(t, router, http.MethodPost, "/warehouse", map[string]any{
+		"address": "Calle 1", "telephone": "555-0001", "warehouse_code": code,
+		"minimum_capacity": 10, "minimum_temperature": -5, "locality_id": localityId,
+	}, http.StatusCreated)
+}
+
+func employeeBody(cardNumber string, warehouseId int) map[string]any {
+	return map[string]any{"id_card_number": cardNumber, "first_name": "Juan", "last_name": "Pérez", "warehouse_id": warehouseId}
+}
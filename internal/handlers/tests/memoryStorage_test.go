@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestMemoryForeignKeys checks that writes referencing a missing parent fail like the MySQL constraints
+// TestMemoryForeignKeys verifica que las escrituras que referencian un padre inexistente fallen como las restricciones de MySQL
+func TestMemoryForeignKeys(t *testing.T) {
+	router := newMemoryRouter(t)
+
+	send(t, router, http.MethodPost, "/warehouse", map[string]any{
+		"address": "Calle 1", "telephone": "555-0001", "warehouse_code": "WH-404",
+		"minimum_capacity": 10, "minimum_temperature": -5, "locality_id": 404,
+	}, http.StatusConflict)
+	send(t, router, http.MethodPost, "/employee/", employeeBody("EMP-404", 404), http.StatusConflict)
+}
+
+// TestMemoryCascade force deletes a warehouse and expects its employees and sections to go with it
+// TestMemoryCascade elimina con force un almacén y espera que sus empleados y secciones se eliminen con él
+func TestMemoryCascade(t *testing.T) {
+	router := newMemoryRouter(t)
+	warehouseId := warehouseFixture(t, router, "WH-01")
+	otherWarehouseId := send(t, router, http.MethodPost, "/warehouse", map[string]any{
+		"address": "Calle 2", "telephone": "555-0002", "warehouse_code": "WH-02",
+		"minimum_capacity": 10, "minimum_temperature": -5, "locality_id": 1,
+	}, http.StatusCreated)
+
+	productTypeId := send(t, router, http.MethodPost, "/productTypes", map[string]any{
+		"description": "Lácteos", "storage_class": "chilled", "minimum_temperature": 0, "maximum_temperature": 6,
+	}, http.StatusCreated)
+	sectionBody := func(number string, warehouseId int) map[string]any {
+		return map[string]any{
+			"section_number": number, "current_capacity": 10, "current_temperature": 4, "maximum_capacity": 100,
+			"minimum_capacity": 5, "minimum_temperature": 2, "product_type_id": productTypeId, "warehouse_id": warehouseId,
+		}
+	}
+	sectionId := send(t, router, http.MethodPost, "/sections/", sectionBody("S-01", warehouseId), http.StatusCreated)
+	otherSectionId := send(t, router, http.MethodPost, "/sections/", sectionBody("S-02", otherWarehouseId), http.StatusCreated)
+	employeeId := send(t, router, http.MethodPost, "/employee/", employeeBody("EMP-1", warehouseId), http.StatusCreated)
+	otherEmployeeId := send(t, router, http.MethodPost, "/employee/", employeeBody("EMP-2", otherWarehouseId), http.StatusCreated)
+
+	// Without force the dependents block the delete / Sin force los dependientes bloquean el borrado
+	send(t, router, http.MethodDelete, fmt.Sprintf("/warehouse/%d", warehouseId), nil, http.StatusConflict)
+	send(t, router, http.MethodGet, fmt.Sprintf("/employee/%d", employeeId), nil, http.StatusOK)
+	send(t, router, http.MethodDelete, fmt.Sprintf("/warehouse/%d?force=true", warehouseId), nil, http.StatusNoContent)
+
+	send(t, router, http.MethodGet, fmt.Sprintf("/employee/%d", employeeId), nil, http.StatusNotFound)
+	send(t, router, http.MethodGet, fmt.Sprintf("/sections/%d", sectionId), nil, http.StatusNotFound)
+	send(t, router, http.MethodGet, fmt.Sprintf("/employee/%d", otherEmployeeId), nil, http.StatusOK)
+	send(t, router, http.MethodGet, fmt.Sprintf("/sections/%d", otherSectionId), nil, http.StatusOK)
+
+	// A product type in use is restricted, not cascaded / Un tipo de producto en uso se restringe, no se propaga
+	send(t, router, http.MethodDelete, fmt.Sprintf("/productTypes/%d", productTypeId), nil, http.StatusConflict)
+	send(t, router, http.MethodDelete, fmt.Sprintf("/sections/%d", otherSectionId), nil, http.StatusNoContent)
+	send(t, router, http.MethodDelete, fmt.Sprintf("/productTypes/%d", productTypeId), nil, http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+)
+
+// Timeout gives the requests of a route group a context that expires after timeout; 0 leaves them unbounded
+// Handlers pass the context down to the database, so an expired request fails with 504 through writeError.
+// A handler that finishes after the deadline without writing anything gets the same envelope here
+// Timeout da a las solicitudes de un grupo de rutas un contexto que vence después de timeout; 0 las deja sin límite
+// Los handlers pasan el contexto hasta la base, así una solicitud vencida falla con 504 a través de writeError.
+// Un handler que termina después del plazo sin escribir nada recibe aquí el mismo sobre
+func Timeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if timeout <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			if ww.Status() == 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				writeError(ctx, ww, error_message.ErrDeadlineExceeded)
+			}
+		})
+	}
+}
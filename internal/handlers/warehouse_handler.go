@@ -1,15 +1,10 @@
 package handlers
 
 import (
-	"context"
-	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
-	"strconv"
-	"time"
 
 	"github.com/bootcamp-go/web/response"
-	"github.com/go-chi/chi/v5"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
@@ -35,30 +30,18 @@ func NewWarehouseHandler(warehouseService services.WarehouseService) *WarehouseH
 // GetAll maneja las solicitudes HTTP GET para recuperar todos los almacenes
 // Retorna una respuesta JSON con todos los almacenes o códigos de error apropiados
 func (h *WarehouseHandler) GetAll(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Get all warehouses from service layer / Obtener todos los almacenes de la capa de servicio
 	warehouses, err := h.warehouseService.GetAll(ctx)
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al leer la base de datos de warehouses")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error al obtener los warehouses")
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Check if any warehouses were found / Verificar si se encontraron almacenes
 	if len(warehouses) == 0 {
-		response.Error(w, http.StatusNotFound, "No se encontraron almacenes")
+		writeError(ctx, w, fmt.Errorf("%w: no warehouses found", error_message.ErrNotFound))
 		return
 	}
 
@@ -78,41 +61,25 @@ func (h *WarehouseHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 // Create maneja las solicitudes HTTP POST para crear un nuevo almacén
 // Valida el cuerpo de la solicitud, unicidad del código y retorna códigos de estado HTTP apropiados
 func (h *WarehouseHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	var warehouseRequest requests.WarehouseRequest
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	if err := json.NewDecoder(r.Body).Decode(&warehouseRequest); err != nil {
-		response.Error(w, http.StatusBadRequest, "Formato JSON inválido")
+	if err := decodeJSON(r, &warehouseRequest); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Validate request structure and business rules / Validar estructura de solicitud y reglas de negocio
 	if err := validations.ValidateWarehouseRequestStruct(warehouseRequest); err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Validate warehouse code uniqueness / Validar unicidad del código de almacén
 	if err := h.warehouseService.ValidateCodeUniqueness(ctx, warehouseRequest.WareHouseCode); err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrAlreadyExists) {
-			response.Error(w, http.StatusConflict, err.Error())
-			return
-		}
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al validar la unicidad del código en la base de datos")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error al validar el código del warehouse")
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -120,17 +87,7 @@ func (h *WarehouseHandler) Create(w http.ResponseWriter, r *http.Request) {
 	warehouse := mappers.ToRequest(warehouseRequest)
 	createdWarehouse, err := h.warehouseService.Create(ctx, warehouse)
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al guardar el warehouse en la base de datos")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error al crear el warehouse")
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -146,42 +103,19 @@ func (h *WarehouseHandler) Create(w http.ResponseWriter, r *http.Request) {
 // GetById maneja las solicitudes HTTP GET para recuperar un almacén por ID
 // Extrae el ID del parámetro de URL y retorna los datos del almacén o códigos de error apropiados
 func (h *WarehouseHandler) GetById(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
-
-	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	idStr := chi.URLParam(r, "id")
-	if idStr == "" {
-		response.Error(w, http.StatusBadRequest, "El ID del almacén es requerido")
-		return
-	}
+	ctx := r.Context()
 
 	// Parse and validate ID parameter / Parsear y validar parámetro ID
-	id, err := strconv.Atoi(idStr)
+	id, err := parseIdParam(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, "El ID del almacén debe ser un número")
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Get warehouse by ID from service layer / Obtener almacén por ID de la capa de servicio
 	warehouse, err := h.warehouseService.GetById(ctx, id)
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al buscar el warehouse en la base de datos")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error al obtener el warehouse")
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -193,45 +127,43 @@ func (h *WarehouseHandler) GetById(w http.ResponseWriter, r *http.Request) {
 }
 
 // Delete handles HTTP DELETE requests to remove a warehouse by ID
-// Extracts the ID from the URL parameter and deletes the warehouse
+// Supports ?dryRun=true to preview dependents and ?force=true to delete them in cascade
 // Delete maneja las solicitudes HTTP DELETE para eliminar un almacén por ID
-// Extrae el ID del parámetro de URL y elimina el almacén
+// Soporta ?dryRun=true para ver los dependientes y ?force=true para borrarlos en cascada
 func (h *WarehouseHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
-
-	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	idStr := chi.URLParam(r, "id")
-	if idStr == "" {
-		response.Error(w, http.StatusBadRequest, "El ID del almacén es requerido")
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
-	// Parse and validate ID parameter / Parsear y validar parámetro ID
-	id, err := strconv.Atoi(idStr)
+	// Parse dryRun and force flags / Parsear flags dryRun y force
+	opts, err := parseDeleteOptions(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, "El ID del almacén debe ser un número")
+		writeError(ctx, w, err)
 		return
 	}
 
-	// Delete warehouse through service layer / Eliminar almacén a través de la capa de servicio
-	if err := h.warehouseService.Delete(ctx, id); err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al eliminar el warehouse de la base de datos")
+	// On dry run only report the dependent rows / En dry run solo reportar las filas dependientes
+	if opts.DryRun {
+		impact, err := h.warehouseService.DeleteImpact(ctx, id)
+		if err != nil {
+			writeError(ctx, w, err)
 			return
 		}
-		response.Error(w, http.StatusInternalServerError, "Error al eliminar el warehouse")
+
+		response.JSON(w, http.StatusOK, responses.DataResponse{
+			Data: mappers.GetDeleteImpactResponseFromModel(id, impact),
+		})
+		return
+	}
+
+	// Delete warehouse through service layer / Eliminar almacén a través de la capa de servicio
+	if err := h.warehouseService.Delete(ctx, id, opts.Force); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -245,56 +177,33 @@ func (h *WarehouseHandler) Delete(w http.ResponseWriter, r *http.Request) {
 // Update maneja las solicitudes HTTP PUT para actualizar un almacén existente
 // Extrae el ID del parámetro de URL, valida unicidad del código y actualiza el almacén
 func (h *WarehouseHandler) Update(w http.ResponseWriter, r *http.Request) {
-	// Set timeout context for the request / Establecer contexto con timeout para la solicitud
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
-
-	// Extract and validate ID parameter from URL / Extraer y validar parámetro ID de la URL
-	idStr := chi.URLParam(r, "id")
-	if idStr == "" {
-		response.Error(w, http.StatusBadRequest, "El ID del almacén es requerido")
-		return
-	}
+	ctx := r.Context()
 
 	// Parse and validate ID parameter / Parsear y validar parámetro ID
-	id, err := strconv.Atoi(idStr)
+	id, err := parseIdParam(r)
 	if err != nil {
-		response.Error(w, http.StatusBadRequest, "El ID del almacén debe ser un número")
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Get existing warehouse by ID for comparison / Obtener almacén existente por ID para comparación
 	existingWarehouse, err := h.warehouseService.GetById(ctx, id)
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al buscar el warehouse en la base de datos")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error al obtener el warehouse")
+		writeError(ctx, w, err)
 		return
 	}
 
 	var warehousePatchRequest requests.WarehousePatchRequest
 
 	// Parse and validate JSON request body / Parsear y validar cuerpo de solicitud JSON
-	if err := json.NewDecoder(r.Body).Decode(&warehousePatchRequest); err != nil {
-		response.Error(w, http.StatusBadRequest, "Formato JSON inválido")
+	if err := decodeJSON(r, &warehousePatchRequest); err != nil {
+		writeError(ctx, w, err)
 		return
 	}
 
 	// Validate request structure for patch operation / Validar estructura de solicitud para operación patch
 	if err := validations.ValidateWarehousePatchRequest(warehousePatchRequest); err != nil {
-		response.Error(w, http.StatusBadRequest, err.Error())
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -304,21 +213,7 @@ func (h *WarehouseHandler) Update(w http.ResponseWriter, r *http.Request) {
 	// Validate code uniqueness only if code has changed / Validar unicidad del código solo si el código ha cambiado
 	if warehousePatchRequest.WareHouseCode != nil && *warehousePatchRequest.WareHouseCode != existingWarehouse.WareHouseCode {
 		if err := h.warehouseService.ValidateCodeUniqueness(ctx, *warehousePatchRequest.WareHouseCode); err != nil {
-			// Handle timeout errors / Manejar errores de timeout
-			if ctx.Err() != nil {
-				response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-				return
-			}
-			// Handle specific error types / Manejar tipos de error específicos
-			if errors.Is(err, error_message.ErrAlreadyExists) {
-				response.Error(w, http.StatusConflict, err.Error())
-				return
-			}
-			if errors.Is(err, error_message.ErrInternalServerError) {
-				response.Error(w, http.StatusInternalServerError, "Error al validar la unicidad del código en la base de datos")
-				return
-			}
-			response.Error(w, http.StatusInternalServerError, "Error al validar el código del warehouse")
+			writeError(ctx, w, err)
 			return
 		}
 	}
@@ -326,21 +221,7 @@ func (h *WarehouseHandler) Update(w http.ResponseWriter, r *http.Request) {
 	// Update warehouse through service layer / Actualizar almacén a través de la capa de servicio
 	updatedWarehouse, err = h.warehouseService.Update(ctx, id, updatedWarehouse)
 	if err != nil {
-		// Handle timeout errors / Manejar errores de timeout
-		if ctx.Err() != nil {
-			response.Error(w, http.StatusRequestTimeout, "Request timeout cancelled")
-			return
-		}
-		// Handle specific error types / Manejar tipos de error específicos
-		if errors.Is(err, error_message.ErrNotFound) {
-			response.Error(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if errors.Is(err, error_message.ErrInternalServerError) {
-			response.Error(w, http.StatusInternalServerError, "Error al actualizar el warehouse en la base de datos")
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "Error al actualizar el warehouse")
+		writeError(ctx, w, err)
 		return
 	}
 
@@ -350,3 +231,29 @@ func (h *WarehouseHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Data: warehouseResponse,
 	})
 }
+
+// GetStock handles HTTP GET requests for the stock overview of a warehouse
+// Returns per section and per product the quantity across batches, capacity utilization and earliest due date
+// GetStock maneja las solicitudes HTTP GET para el resumen de stock de un almacén
+// Retorna por sección y por producto la cantidad entre lotes, la utilización de capacidad y el vencimiento más próximo
+func (h *WarehouseHandler) GetStock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse and validate ID parameter / Parsear y validar parámetro ID
+	id, err := parseIdParam(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	// Get warehouse stock from service layer / Obtener el stock del almacén de la capa de servicio
+	stock, err := h.warehouseService.GetStock(ctx, id)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.DataResponse{
+		Data: mappers.ToWarehouseStockResponse(stock),
+	})
+}
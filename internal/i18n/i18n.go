@@ -0,0 +1,86 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// English / Inglés
+	LangEN = "en"
+	// Spanish / Español
+	LangES = "es"
+
+	// DefaultLang is used when the client does not ask for a supported language
+	// DefaultLang se usa cuando el cliente no pide un idioma soportado
+	DefaultLang = LangEN
+)
+
+type langKey struct{}
+
+// WithLang returns a copy of ctx carrying lang / WithLang devuelve una copia de ctx con lang
+func WithLang(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+// LangFrom returns the language stored in ctx, DefaultLang when there is none
+// LangFrom devuelve el idioma guardado en ctx, DefaultLang si no hay ninguno
+func LangFrom(ctx context.Context) string {
+	if lang, ok := ctx.Value(langKey{}).(string); ok {
+		return lang
+	}
+	return DefaultLang
+}
+
+// Negotiate picks the supported language with the highest weight in an Accept-Language header,
+// like "es-AR,es;q=0.9,en;q=0.8"; ties keep the header order
+// Negotiate elige el idioma soportado con mayor peso de un header Accept-Language,
+// como "es-AR,es;q=0.9,en;q=0.8"; los empates respetan el orden del header
+func Negotiate(header string) string {
+	best, bestWeight := DefaultLang, 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if _, ok := catalog[lang]; !ok {
+			continue
+		}
+
+		weight := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if weight > bestWeight {
+			best, bestWeight = lang, weight
+		}
+	}
+	return best
+}
+
+// Middleware stores the negotiated language in the request context and announces it in Content-Language
+// Middleware guarda el idioma negociado en el contexto de la solicitud y lo informa en Content-Language
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := Negotiate(r.Header.Get("Accept-Language"))
+		w.Header().Set("Content-Language", lang)
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r.WithContext(WithLang(r.Context(), lang)))
+	})
+}
+
+// Message returns the catalog message for code in lang, falling back to DefaultLang
+// ok is false when no language knows the code
+// Message devuelve el mensaje del catálogo para code en lang, recurriendo a DefaultLang
+// ok es false cuando ningún idioma conoce el código
+func Message(lang, code string) (message string, ok bool) {
+	if message, ok = catalog[lang][code]; ok {
+		return message, true
+	}
+	message, ok = catalog[DefaultLang][code]
+	return message, ok
+}
@@ -0,0 +1,82 @@
+package i18n
+
+// catalog holds every client facing message keyed by language and code
+// Error codes match the ones written in the error envelope; validation codes match ozzo-validation
+// and may use its {{.param}} placeholders
+// catalog contiene todos los mensajes para el cliente indexados por idioma y código
+// Los códigos de error coinciden con los del sobre de error; los de validación coinciden con ozzo-validation
+// y pueden usar sus marcadores {{.param}}
+var catalog = map[string]map[string]string{
+	LangEN: {
+		// Error codes / Códigos de error
+		"bad_request":          "The request is malformed",
+		"invalid_input":        "The provided input is invalid or missing required fields",
+		"not_found":            "The requested resource was not found",
+		"already_exists":       "A resource with the provided identifier already exists",
+		"has_dependents":       "The resource still has dependent records",
+		"dependency_not_found": "A required related resource was not found",
+		"request_timeout":      "The request timed out",
+		"deadline_exceeded":    "The request took longer than allowed and was aborted",
+		"internal_error":       "An unexpected internal server error occurred",
+
+		// Validation codes / Códigos de validación
+		"validation_required":                        "cannot be blank",
+		"validation_nil_or_not_empty_required":       "cannot be blank",
+		"validation_not_nil_required":                "is required",
+		"validation_empty":                           "must be blank",
+		"validation_nil":                             "must be blank",
+		"validation_in_invalid":                      "must be a valid value",
+		"validation_not_in_invalid":                  "must not be in list",
+		"validation_match_invalid":                   "must be in a valid format",
+		"validation_date_invalid":                    "must be a valid date",
+		"validation_date_out_of_range":               "the date is out of range",
+		"validation_length_invalid":                  "the length must be exactly {{.min}}",
+		"validation_length_out_of_range":             "the length must be between {{.min}} and {{.max}}",
+		"validation_length_too_long":                 "the length must be no more than {{.max}}",
+		"validation_length_too_short":                "the length must be no less than {{.min}}",
+		"validation_min_greater_equal_than_required": "must be no less than {{.threshold}}",
+		"validation_min_greater_than_required":       "must be greater than {{.threshold}}",
+		"validation_max_less_equal_than_required":    "must be no greater than {{.threshold}}",
+		"validation_max_less_than_required":          "must be less than {{.threshold}}",
+		"validation_storage_class_invalid":           "must be one of frozen, chilled or ambient",
+		"validation_temperature_range_invalid":       "must be greater than or equal to minimum_temperature",
+		"validation_type_invalid":                    "must be of type {{.type}}",
+		"validation_field_unknown":                   "is not a known field",
+	},
+	LangES: {
+		// Error codes / Códigos de error
+		"bad_request":          "La solicitud está mal formada",
+		"invalid_input":        "Los datos enviados son inválidos o faltan campos obligatorios",
+		"not_found":            "No se encontró el recurso solicitado",
+		"already_exists":       "Ya existe un recurso con el identificador indicado",
+		"has_dependents":       "El recurso todavía tiene registros dependientes",
+		"dependency_not_found": "No se encontró un recurso relacionado requerido",
+		"request_timeout":      "La solicitud excedió el tiempo de espera",
+		"deadline_exceeded":    "La solicitud tardó más de lo permitido y se abortó",
+		"internal_error":       "Ocurrió un error interno inesperado en el servidor",
+
+		// Validation codes / Códigos de validación
+		"validation_required":                        "no puede estar vacío",
+		"validation_nil_or_not_empty_required":       "no puede estar vacío",
+		"validation_not_nil_required":                "es obligatorio",
+		"validation_empty":                           "debe estar vacío",
+		"validation_nil":                             "debe estar vacío",
+		"validation_in_invalid":                      "debe ser un valor válido",
+		"validation_not_in_invalid":                  "no debe estar en la lista",
+		"validation_match_invalid":                   "debe tener un formato válido",
+		"validation_date_invalid":                    "debe ser una fecha válida",
+		"validation_date_out_of_range":               "la fecha está fuera de rango",
+		"validation_length_invalid":                  "la longitud debe ser exactamente {{.min}}",
+		"validation_length_out_of_range":             "la longitud debe estar entre {{.min}} y {{.max}}",
+		"validation_length_too_long":                 "la longitud no debe superar {{.max}}",
+		"validation_length_too_short":                "la longitud no debe ser menor a {{.min}}",
+		"validation_min_greater_equal_than_required": "no debe ser menor a {{.threshold}}",
+		"validation_min_greater_than_required":       "debe ser mayor a {{.threshold}}",
+		"validation_max_less_equal_than_required":    "no debe ser mayor a {{.threshold}}",
+		"validation_max_less_than_required":          "debe ser menor a {{.threshold}}",
+		"validation_storage_class_invalid":           "debe ser frozen, chilled o ambient",
+		"validation_temperature_range_invalid":       "debe ser mayor o igual a minimum_temperature",
+		"validation_type_invalid":                    "debe ser de tipo {{.type}}",
+		"validation_field_unknown":                   "no es un campo conocido",
+	},
+}
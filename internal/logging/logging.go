@@ -0,0 +1,115 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Supported formats / Formatos soportados
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+type ctxKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID / WithRequestID devuelve una copia de ctx con el ID de la solicitud
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// RequestID returns the request ID stored in ctx, or "" / RequestID devuelve el ID de la solicitud guardado en ctx, o ""
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// New builds a logger writing to w; level is debug, info, warn or error and format is json or text
+// Records logged with a context carrying a request ID get a request_id attribute, and trace_id and span_id when it carries a span
+// New arma un logger que escribe en w; level es debug, info, warn o error y format es json o text
+// Los registros hechos con un contexto que tiene ID de solicitud reciben el atributo request_id, y trace_id y span_id cuando tiene un span
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: must be debug, info, warn or error", level)
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl, AddSource: true, ReplaceAttr: shortSource}
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		return slog.New(contextHandler{slog.NewJSONHandler(w, opts)}), nil
+	case FormatText:
+		return slog.New(contextHandler{slog.NewTextHandler(w, opts)}), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q: must be json or text", format)
+	}
+}
+
+// Error logs err at error level attributing the record to the function depth frames above the caller of Error
+// so a helper like repositories.dbError reports the repository method that failed
+// Error registra err en nivel error atribuyendo el registro a la función depth niveles por encima de quien llama a Error
+// así un helper como repositories.dbError reporta el método del repositorio que falló
+func Error(ctx context.Context, depth int, msg string, err error) {
+	logger := slog.Default()
+	if !logger.Enabled(ctx, slog.LevelError) {
+		return
+	}
+
+	// Skip runtime.Callers, Error and the requested frames / Saltear runtime.Callers, Error y los niveles pedidos
+	var pcs [1]uintptr
+	runtime.Callers(2+depth, pcs[:])
+	record := slog.NewRecord(time.Now(), slog.LevelError, msg, pcs[0])
+	record.AddAttrs(slog.Any("error", err))
+	_ = logger.Handler().Handle(ctx, record)
+}
+
+// contextHandler adds the request ID and the trace of the context to every record
+// contextHandler agrega el ID de solicitud y la traza del contexto a cada registro
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		record.AddAttrs(slog.String("trace_id", span.TraceID().String()), slog.String("span_id", span.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// shortSource trims the source file to its last two path elements, like handlers/errorResponse.go:80
+// shortSource recorta el archivo fuente a sus dos últimos elementos, como handlers/errorResponse.go:80
+func shortSource(groups []string, attr slog.Attr) slog.Attr {
+	if attr.Key != slog.SourceKey {
+		return attr
+	}
+	source, ok := attr.Value.Any().(*slog.Source)
+	if !ok || source == nil {
+		return attr
+	}
+
+	file := source.File
+	if i := strings.LastIndex(file, "/"); i > 0 {
+		if j := strings.LastIndex(file[:i], "/"); j >= 0 {
+			file = file[j+1:]
+		}
+	}
+	return slog.String(slog.SourceKey, fmt.Sprintf("%s:%d %s", file, source.Line, source.Function[strings.LastIndex(source.Function, "/")+1:]))
+}
@@ -0,0 +1,18 @@
+package mappers
+
+import (
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+func GetDeleteImpactResponseFromModel(id int, model models.DeleteImpact) responses.DeleteImpactResponse {
+	return responses.DeleteImpactResponse{
+		ID:             id,
+		Sections:       model.Sections,
+		ProductBatches: model.ProductBatches,
+		Employees:      model.Employees,
+		InboundOrders:  model.InboundOrders,
+		Total:          model.Total(),
+		CanDelete:      model.Total() == 0,
+	}
+}
@@ -0,0 +1,94 @@
+package mappers
+
+import (
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+func ToCountryResponse(country models.Country) responses.CountryResponse {
+	return responses.CountryResponse{ID: country.Id, CountryName: country.CountryName}
+}
+
+func ToProvinceResponse(province models.Province) responses.ProvinceResponse {
+	return responses.ProvinceResponse{
+		ID:           province.Id,
+		ProvinceName: province.ProvinceName,
+		CountryID:    province.CountryId,
+		CountryName:  province.CountryName,
+	}
+}
+
+func ToLocalityResponse(locality models.Locality) responses.LocalityResponse {
+	return responses.LocalityResponse{
+		ID:           locality.Id,
+		LocalityName: locality.LocalityName,
+		ProvinceID:   locality.ProvinceId,
+		ProvinceName: locality.ProvinceName,
+		CountryID:    locality.CountryId,
+		CountryName:  locality.CountryName,
+	}
+}
+
+func ToGeographyTreeResponse(tree []models.CountryNode) []responses.CountryNodeResponse {
+	countries := make([]responses.CountryNodeResponse, 0, len(tree))
+	for _, country := range tree {
+		provinces := make([]responses.ProvinceNodeResponse, 0, len(country.Provinces))
+		for _, province := range country.Provinces {
+			localities := make([]responses.LocalityNodeResponse, 0, len(province.Localities))
+			for _, locality := range province.Localities {
+				localities = append(localities, responses.LocalityNodeResponse{ID: locality.Id, LocalityName: locality.LocalityName})
+			}
+			provinces = append(provinces, responses.ProvinceNodeResponse{ID: province.Id, ProvinceName: province.ProvinceName, Localities: localities})
+		}
+		countries = append(countries, responses.CountryNodeResponse{ID: country.Id, CountryName: country.CountryName, Provinces: provinces})
+	}
+	return countries
+}
+
+func ToGeographyMergeResponse(result models.GeographyMergeResult) responses.GeographyMergeResponse {
+	return responses.GeographyMergeResponse{
+		SourceID:   result.SourceId,
+		TargetID:   result.TargetId,
+		Provinces:  result.Provinces,
+		Localities: result.Localities,
+		Sellers:    result.Sellers,
+		Carriers:   result.Carriers,
+		Warehouses: result.Warehouses,
+	}
+}
+
+func ApplyCountryPatch(existing models.Country, patch requests.CountryPatchRequest) models.Country {
+	if patch.CountryName != nil {
+		existing.CountryName = *patch.CountryName
+	}
+	return existing
+}
+
+func ApplyProvincePatch(existing models.Province, patch requests.ProvincePatchRequest) models.Province {
+	if patch.ProvinceName != nil {
+		existing.ProvinceName = *patch.ProvinceName
+	}
+	if patch.CountryId != nil {
+		existing.CountryId = *patch.CountryId
+	}
+	return existing
+}
+
+func ApplyLocalityPatch(existing models.Locality, patch requests.LocalityPatchRequest) models.Locality {
+	if patch.LocalityName != nil {
+		existing.LocalityName = *patch.LocalityName
+	}
+	if patch.ProvinceId != nil {
+		existing.ProvinceId = *patch.ProvinceId
+	}
+	return existing
+}
+
+func ToLocalitySearchResponse(match models.LocalityMatch) responses.LocalitySearchResponse {
+	return responses.LocalitySearchResponse{
+		LocalityResponse: ToLocalityResponse(match.Locality),
+		MatchedOn:        match.MatchedOn,
+		Distance:         match.Distance,
+	}
+}
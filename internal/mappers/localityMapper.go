@@ -2,6 +2,7 @@ package mappers
 
 import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
@@ -17,3 +18,19 @@ func ToRequestToLocalityStruct(locality requests.LocalityRequest) models.Localit
 	}
 	return localityFormated
 }
+
+func ToLocalityDashboardResponse(dashboard models.LocalityDashboard) responses.LocalityDashboardResponse {
+	return responses.LocalityDashboardResponse{
+		Level:           dashboard.Level,
+		ID:              dashboard.Id,
+		Name:            dashboard.Name,
+		ProvinceID:      dashboard.ProvinceId,
+		ProvinceName:    dashboard.ProvinceName,
+		CountryID:       dashboard.CountryId,
+		CountryName:     dashboard.CountryName,
+		LocalitiesCount: dashboard.Localities,
+		SellersCount:    dashboard.Sellers,
+		CarriersCount:   dashboard.Carriers,
+		WarehousesCount: dashboard.Warehouses,
+	}
+}
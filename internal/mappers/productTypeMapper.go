@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+func ToProductTypeResponse(productType models.ProductType) responses.ProductTypeResponse {
+	return responses.ProductTypeResponse{
+		ID:                 productType.Id,
+		Description:        productType.Description,
+		StorageClass:       productType.StorageClass,
+		MinimumTemperature: productType.MinimumTemperature,
+		MaximumTemperature: productType.MaximumTemperature,
+	}
+}
+
+func ToProductTypeModel(request requests.ProductTypeRequest) models.ProductType {
+	return models.ProductType{
+		Description:        request.Description,
+		StorageClass:       request.StorageClass,
+		MinimumTemperature: request.MinimumTemperature,
+		MaximumTemperature: request.MaximumTemperature,
+	}
+}
+
+func ApplyProductTypePatch(existing models.ProductType, patch requests.ProductTypePatchRequest) models.ProductType {
+	if patch.Description != nil {
+		existing.Description = *patch.Description
+	}
+	if patch.StorageClass != nil {
+		existing.StorageClass = *patch.StorageClass
+	}
+	if patch.MinimumTemperature != nil {
+		existing.MinimumTemperature = *patch.MinimumTemperature
+	}
+	if patch.MaximumTemperature != nil {
+		existing.MaximumTemperature = *patch.MaximumTemperature
+	}
+	return existing
+}
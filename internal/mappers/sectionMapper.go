@@ -14,6 +14,8 @@ func GetSectionModelFromRequest(request *requests.SectionRequest) *models.Sectio
 		MaximumCapacity:    request.MaximumCapacity,
 		MinimumCapacity:    request.MinimumCapacity,
 		MinimumTemperature: request.MinimumTemperature,
+		MaximumVolume:      request.MaximumVolume,
+		MaximumWeight:      request.MaximumWeight,
 		ProductTypeID:      request.ProductTypeID,
 		WarehouseID:        request.WarehouseID,
 	}
@@ -28,6 +30,8 @@ func GetSectionResponseFromModel(model *models.Section) *responses.SectionRespon
 		MaximumCapacity:    model.MaximumCapacity,
 		MinimumCapacity:    model.MinimumCapacity,
 		MinimumTemperature: model.MinimumTemperature,
+		MaximumVolume:      model.MaximumVolume,
+		MaximumWeight:      model.MaximumWeight,
 		ProductTypeID:      model.ProductTypeID,
 		WarehouseID:        model.WarehouseID,
 	}
@@ -40,6 +44,8 @@ func UpdateSectionModelFromRequest(model *models.Section, request *requests.Sect
 	model.MaximumCapacity = request.MaximumCapacity
 	model.MinimumCapacity = request.MinimumCapacity
 	model.MinimumTemperature = request.MinimumTemperature
+	model.MaximumVolume = request.MaximumVolume
+	model.MaximumWeight = request.MaximumWeight
 	model.ProductTypeID = request.ProductTypeID
 	model.WarehouseID = request.WarehouseID
 }
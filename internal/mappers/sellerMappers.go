@@ -1,6 +1,8 @@
 package mappers
 
 import (
+	"time"
+
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
@@ -28,3 +30,23 @@ func ToSellerStructToResponse(seller models.Seller) responses.SellerResponse {
 	}
 	return sellerFormated
 }
+
+func ToSellerReportToResponse(report models.SellerReport) responses.SellerReportResponse {
+	reportFormated := responses.SellerReportResponse{
+		SellerID:      report.SellerId,
+		CompanyName:   report.CompanyName,
+		ProductsCount: report.ProductsCount,
+		BatchStock:    report.BatchStock,
+		UnitsSold:     report.UnitsSold,
+		Revenue:       report.Revenue,
+	}
+	if report.From != nil {
+		from := report.From.Format(time.DateOnly)
+		reportFormated.From = &from
+	}
+	if report.To != nil {
+		to := report.To.Format(time.DateOnly)
+		reportFormated.To = &to
+	}
+	return reportFormated
+}
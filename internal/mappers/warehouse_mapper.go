@@ -1,6 +1,9 @@
 package mappers
 
 import (
+	"math"
+	"time"
+
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
@@ -46,3 +49,60 @@ func ApplyPatch(existing models.Warehouse, patch requests.WarehousePatchRequest)
 	}
 	return existing
 }
+
+func ToWarehouseStockResponse(stock models.WarehouseStock) responses.WarehouseStockResponse {
+	sections := make([]responses.SectionStockResponse, 0, len(stock.Sections))
+	for _, section := range stock.Sections {
+		products := make([]responses.ProductStockResponse, 0, len(section.Products))
+		for _, product := range section.Products {
+			products = append(products, responses.ProductStockResponse{
+				ProductID:       product.ProductId,
+				ProductCode:     product.ProductCode,
+				Description:     product.Description,
+				BatchesCount:    product.Batches,
+				TotalQuantity:   product.Quantity,
+				TotalVolume:     roundMeasure(product.Volume),
+				TotalWeight:     roundMeasure(product.Weight),
+				EarliestDueDate: formatDueDate(product.EarliestDueDate),
+			})
+		}
+		sections = append(sections, responses.SectionStockResponse{
+			SectionID:             section.SectionId,
+			SectionNumber:         section.SectionNumber,
+			MaximumCapacity:       section.MaximumCapacity,
+			MaximumVolume:         section.MaximumVolume,
+			MaximumWeight:         section.MaximumWeight,
+			TotalQuantity:         section.Quantity,
+			TotalVolume:           roundMeasure(section.Volume),
+			TotalWeight:           roundMeasure(section.Weight),
+			UtilizationPercentage: section.Utilization,
+			VolumeUtilization:     section.VolumeUtilization,
+			WeightUtilization:     section.WeightUtilization,
+			EarliestDueDate:       formatDueDate(section.EarliestDueDate),
+			Products:              products,
+		})
+	}
+
+	return responses.WarehouseStockResponse{
+		WarehouseID:     stock.WarehouseId,
+		WarehouseCode:   stock.WarehouseCode,
+		TotalQuantity:   stock.Quantity,
+		EarliestDueDate: formatDueDate(stock.EarliestDueDate),
+		Sections:        sections,
+	}
+}
+
+// formatDueDate formats an optional due date as YYYY-MM-DD, empty when missing
+// formatDueDate formatea una fecha de vencimiento opcional como YYYY-MM-DD, vacía cuando falta
+func formatDueDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
+// roundMeasure rounds volumes (m³) and weights (kg) to four decimals, hiding float noise from the products of dimensions
+// roundMeasure redondea volúmenes (m³) y pesos (kg) a cuatro decimales, ocultando el ruido de punto flotante de multiplicar dimensiones
+func roundMeasure(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
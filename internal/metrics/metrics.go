@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+const namespace = "meli_frescos"
+
+// scrapeTimeout bounds the queries run for the business gauges / scrapeTimeout limita las consultas de los indicadores de negocio
+const scrapeTimeout = 5 * time.Second
+
+// expiringWindows are the horizons reported by the expiring batches gauge
+// expiringWindows son los horizontes que reporta el indicador de lotes por vencer
+var expiringWindows = []struct {
+	label  string
+	within time.Duration
+}{
+	{"1d", 24 * time.Hour},
+	{"7d", 7 * 24 * time.Hour},
+	{"30d", 30 * 24 * time.Hour},
+}
+
+// StockSource provides the units per warehouse, implemented by services.WarehouseService
+// StockSource provee las unidades por almacén, implementado por services.WarehouseService
+type StockSource interface {
+	GetStockTotals(ctx context.Context) ([]models.WarehouseStockTotal, error)
+}
+
+// BatchSource counts batches by due date, implemented by services.ProductBatchServiceI
+// BatchSource cuenta lotes por fecha de vencimiento, implementado por services.ProductBatchServiceI
+type BatchSource interface {
+	CountDueBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// Metrics owns a registry with the HTTP, database pool and business metrics of one router
+// Every router gets its own registry so several containers can coexist in one process
+// Metrics tiene un registro con las métricas HTTP, del pool de la base y de negocio de un router
+// Cada router tiene su propio registro así varios contenedores pueden coexistir en un proceso
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// New registers the metrics; db is nil for in-memory storage and then no pool stats are exported
+// New registra las métricas; db es nil para el almacenamiento en memoria y entonces no se exportan estadísticas del pool
+func New(db *sql.DB, stock StockSource, batches BatchSource) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route pattern and status.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route pattern and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.duration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		&businessCollector{stock: stock, batches: batches},
+	)
+	if db != nil {
+		m.registry.MustRegister(collectors.NewDBStatsCollector(db, "mysql"))
+	}
+	return m
+}
+
+// Middleware records every request under its chi route pattern, so /sellers/{id} is one series for every id
+// Requests that match no route are recorded as "unmatched"
+// Middleware registra cada solicitud bajo su patrón de ruta de chi, así /sellers/{id} es una sola serie para todos los id
+// Las solicitudes que no coinciden con ninguna ruta se registran como "unmatched"
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		labels := prometheus.Labels{"method": r.Method, "route": route, "status": strconv.Itoa(status)}
+		m.requests.With(labels).Inc()
+		m.duration.With(labels).Observe(time.Since(start).Seconds())
+	})
+}
+
+// Handler serves the registry in the Prometheus text format / Handler sirve el registro en el formato de texto de Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+var (
+	stockUnitsDesc = prometheus.NewDesc(namespace+"_warehouse_stock_units",
+		"Units stored in the batches of each warehouse.", []string{"warehouse_id", "warehouse_code"}, nil)
+	expiredBatchesDesc = prometheus.NewDesc(namespace+"_product_batches_expired",
+		"Batches with stock left whose due date has passed.", nil, nil)
+	expiringBatchesDesc = prometheus.NewDesc(namespace+"_product_batches_expiring",
+		"Batches with stock left that expire within the window.", []string{"window"}, nil)
+)
+
+// businessCollector queries the KPIs on every scrape; a failed query is logged and its metric skipped
+// businessCollector consulta los indicadores en cada scrape; una consulta fallida se registra y su métrica se omite
+type businessCollector struct {
+	stock   StockSource
+	batches BatchSource
+}
+
+func (c *businessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- stockUnitsDesc
+	ch <- expiredBatchesDesc
+	ch <- expiringBatchesDesc
+}
+
+func (c *businessCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	totals, err := c.stock.GetStockTotals(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "metrics: warehouse stock", "error", err)
+	}
+	for _, total := range totals {
+		ch <- prometheus.MustNewConstMetric(stockUnitsDesc, prometheus.GaugeValue, float64(total.Quantity),
+			strconv.Itoa(total.WarehouseId), total.WarehouseCode)
+	}
+
+	now := time.Now()
+	expired, err := c.batches.CountDueBefore(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "metrics: expired batches", "error", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(expiredBatchesDesc, prometheus.GaugeValue, float64(expired))
+
+	// Expiring batches are the ones due inside the window but not yet expired
+	// Los lotes por vencer son los que vencen dentro de la ventana pero todavía no vencieron
+	for _, window := range expiringWindows {
+		dueBefore, err := c.batches.CountDueBefore(ctx, now.Add(window.within))
+		if err != nil {
+			slog.ErrorContext(ctx, "metrics: expiring batches", "error", err)
+			return
+		}
+		ch <- prometheus.MustNewConstMetric(expiringBatchesDesc, prometheus.GaugeValue, float64(dueBefore-expired), window.label)
+	}
+}
@@ -0,0 +1,362 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed sql/*.sql
+var migrationFiles embed.FS
+
+// Migration table and query constants / Constantes de tabla y consultas de migraciones
+const (
+	migrationsTable = "schema_migrations"
+
+	queryCreateMigrationsTable = "CREATE TABLE IF NOT EXISTS `" + migrationsTable + "` (" +
+		"`version` BIGINT NOT NULL, " +
+		"`name` VARCHAR(255) NOT NULL, " +
+		"`applied_at` DATETIME NOT NULL, " +
+		"PRIMARY KEY (`version`))"
+	queryMigrationsTableExists = "SELECT COUNT(*) FROM `information_schema`.`tables` WHERE `table_schema` = DATABASE() AND `table_name` = '" + migrationsTable + "'"
+	queryGetAppliedMigrations  = "SELECT `version`, `applied_at` FROM `" + migrationsTable + "` ORDER BY `version`"
+	queryInsertMigration       = "INSERT INTO `" + migrationsTable + "` (`version`, `name`, `applied_at`) VALUES (?, ?, ?)"
+	queryDeleteMigration       = "DELETE FROM `" + migrationsTable + "` WHERE `version` = ?"
+)
+
+// fileNamePattern matches files like 0001_initial_schema.up.sql
+// fileNamePattern coincide con archivos como 0001_initial_schema.up.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// ErrUnknownVersion is returned when a target version has no migration file
+// ErrUnknownVersion se devuelve cuando una versión objetivo no tiene archivo de migración
+var ErrUnknownVersion = errors.New("migrations: unknown version")
+
+// Migration is a single versioned schema change with its up and down scripts
+// Migration es un cambio de esquema versionado con sus scripts up y down
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus reports whether a migration has been applied and when
+// MigrationStatus reporta si una migración fue aplicada y cuándo
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator applies and reverts the embedded migrations against a database
+// Migrator aplica y revierte las migraciones embebidas contra una base de datos
+type Migrator struct {
+	db         *sql.DB     // Database connection / Conexión a la base de datos
+	migrations []Migration // Migrations sorted by version / Migraciones ordenadas por versión
+}
+
+// NewMigrator creates a Migrator loading the migrations embedded in the binary
+// NewMigrator crea un Migrator cargando las migraciones embebidas en el binario
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := Load(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load reads every *.up.sql / *.down.sql pair under sql/ and returns them sorted by version
+// Load lee cada par *.up.sql / *.down.sql dentro de sql/ y los retorna ordenados por versión
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("migrations: reading directory: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("migrations: invalid file name %q", entry.Name())
+		}
+
+		version, _ := strconv.ParseInt(matches[1], 10, 64)
+		content, err := fs.ReadFile(fsys, path.Join("sql", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("migrations: reading %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("migrations: version %d has mismatched names %q and %q", version, m.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migrations: version %d must have both up and down files", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Status returns every known migration with its applied state
+// Status retorna todas las migraciones conocidas con su estado de aplicación
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		appliedAt, ok := applied[migration.Version]
+		status = append(status, MigrationStatus{Migration: migration, Applied: ok, AppliedAt: appliedAt})
+	}
+	return status, nil
+}
+
+// Pending returns the migrations that have not been applied yet
+// Pending retorna las migraciones que todavía no fueron aplicadas
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []Migration{}
+	for _, s := range status {
+		if !s.Applied {
+			pending = append(pending, s.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration in ascending order
+// Up aplica todas las migraciones pendientes en orden ascendente
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	if len(m.migrations) == 0 {
+		return nil, nil
+	}
+	return m.To(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down reverts the last applied migrations, as many as steps
+// Down revierte las últimas migraciones aplicadas, tantas como steps
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reverted := []Migration{}
+	for i := len(status) - 1; i >= 0 && len(reverted) < steps; i-- {
+		if !status[i].Applied {
+			continue
+		}
+		if err := m.revert(ctx, status[i].Migration); err != nil {
+			return reverted, err
+		}
+		reverted = append(reverted, status[i].Migration)
+	}
+	return reverted, nil
+}
+
+// To migrates up or down until exactly the migrations up to version are applied; version 0 reverts all
+// To migra hacia arriba o abajo hasta que estén aplicadas exactamente las migraciones hasta version; version 0 revierte todas
+func (m *Migrator) To(ctx context.Context, version int64) ([]Migration, error) {
+	if version != 0 && !m.known(version) {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []Migration{}
+
+	// Revert newer migrations from the highest down / Revertir migraciones más nuevas de la más alta hacia abajo
+	for i := len(status) - 1; i >= 0; i-- {
+		if status[i].Applied && status[i].Version > version {
+			if err := m.revert(ctx, status[i].Migration); err != nil {
+				return changed, err
+			}
+			changed = append(changed, status[i].Migration)
+		}
+	}
+
+	// Apply missing migrations from the lowest up / Aplicar migraciones faltantes de la más baja hacia arriba
+	for _, s := range status {
+		if !s.Applied && s.Version <= version {
+			if err := m.apply(ctx, s.Migration); err != nil {
+				return changed, err
+			}
+			changed = append(changed, s.Migration)
+		}
+	}
+
+	return changed, nil
+}
+
+// known reports whether a migration with the given version exists
+// known indica si existe una migración con la versión dada
+func (m *Migrator) known(version int64) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureTable creates the tracking table; only the paths that change the schema call it
+// ensureTable crea la tabla de control; solo la llaman los caminos que modifican el esquema
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	if _, err := m.db.ExecContext(ctx, queryCreateMigrationsTable); err != nil {
+		return fmt.Errorf("migrations: creating %s: %w", migrationsTable, err)
+	}
+	return nil
+}
+
+// applied returns the applied versions; a missing tracking table means nothing was applied yet
+// It never writes, so Status and Pending are safe to call from health probes
+// applied retorna las versiones aplicadas; si la tabla de control no existe no se aplicó nada todavía
+// Nunca escribe, así Status y Pending se pueden llamar desde los probes de salud
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	var tables int
+	if err := m.db.QueryRowContext(ctx, queryMigrationsTableExists).Scan(&tables); err != nil {
+		return nil, fmt.Errorf("migrations: looking up %s: %w", migrationsTable, err)
+	}
+	if tables == 0 {
+		return map[int64]time.Time{}, nil
+	}
+
+	rows, err := m.db.QueryContext(ctx, queryGetAppliedMigrations)
+	if err != nil {
+		return nil, fmt.Errorf("migrations: reading %s: %w", migrationsTable, err)
+	}
+	defer rows.Close()
+
+	applied := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("migrations: scanning %s: %w", migrationsTable, err)
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// apply runs the up script and records the version
+// apply ejecuta el script up y registra la versión
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	if err := m.exec(ctx, migration.Up); err != nil {
+		return fmt.Errorf("migrations: applying %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if _, err := m.db.ExecContext(ctx, queryInsertMigration, migration.Version, migration.Name, time.Now().UTC()); err != nil {
+		return fmt.Errorf("migrations: recording %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// revert runs the down script and removes the version record
+// revert ejecuta el script down y elimina el registro de la versión
+func (m *Migrator) revert(ctx context.Context, migration Migration) error {
+	if err := m.exec(ctx, migration.Down); err != nil {
+		return fmt.Errorf("migrations: reverting %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	if _, err := m.db.ExecContext(ctx, queryDeleteMigration, migration.Version); err != nil {
+		return fmt.Errorf("migrations: unrecording %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// exec runs each statement of a script; MySQL DDL is not transactional so they run one by one
+// exec ejecuta cada sentencia de un script; el DDL de MySQL no es transaccional así que se ejecutan una a una
+func (m *Migrator) exec(ctx context.Context, script string) error {
+	for _, statement := range SplitStatements(script) {
+		if _, err := m.db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitStatements splits a SQL script on semicolons, skipping "--" comments and quoted text
+// SplitStatements divide un script SQL por punto y coma, omitiendo comentarios "--" y texto entre comillas
+func SplitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	var quote rune
+
+	runes := []rune(script)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case quote != 0:
+			current.WriteRune(r)
+			if r == '\\' && i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			current.WriteRune(r)
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			current.WriteRune('\n')
+		case r == ';':
+			if statement := strings.TrimSpace(current.String()); statement != "" {
+				statements = append(statements, statement)
+			}
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+	return statements
+}
@@ -0,0 +1,149 @@
+package migrations
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// TestLoad covers the pairing, ordering and file name checks of the migration loader
+// TestLoad cubre el emparejamiento, el orden y la validación de nombres del cargador de migraciones
+func TestLoad(t *testing.T) {
+	file := func(content string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(content)} }
+
+	cases := []struct {
+		name     string
+		files    fstest.MapFS
+		versions []int64
+		err      string
+	}{
+		{
+			name: "pairs sorted by version",
+			files: fstest.MapFS{
+				"sql/0010_add_index.up.sql":        file("CREATE INDEX"),
+				"sql/0010_add_index.down.sql":      file("DROP INDEX"),
+				"sql/0002_add_column.up.sql":       file("ALTER TABLE ADD"),
+				"sql/0002_add_column.down.sql":     file("ALTER TABLE DROP"),
+				"sql/0001_initial_schema.up.sql":   file("CREATE TABLE"),
+				"sql/0001_initial_schema.down.sql": file("DROP TABLE"),
+			},
+			versions: []int64{1, 2, 10},
+		},
+		{
+			name:  "invalid file name",
+			files: fstest.MapFS{"sql/0001-initial.up.sql": file("CREATE TABLE")},
+			err:   "invalid file name",
+		},
+		{
+			name: "mismatched names",
+			files: fstest.MapFS{
+				"sql/0001_initial.up.sql": file("CREATE TABLE"),
+				"sql/0001_first.down.sql": file("DROP TABLE"),
+			},
+			err: "mismatched names",
+		},
+		{
+			name:  "missing down file",
+			files: fstest.MapFS{"sql/0001_initial.up.sql": file("CREATE TABLE")},
+			err:   "must have both up and down files",
+		},
+		{
+			name:  "missing directory",
+			files: fstest.MapFS{},
+			err:   "reading directory",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			migrations, err := Load(tc.files)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			versions := []int64{}
+			for _, m := range migrations {
+				versions = append(versions, m.Version)
+			}
+			if !slices.Equal(versions, tc.versions) {
+				t.Fatalf("expected versions %v, got %v", tc.versions, versions)
+			}
+			if migrations[0].Name != "initial_schema" || migrations[0].Up != "CREATE TABLE" || migrations[0].Down != "DROP TABLE" {
+				t.Errorf("unexpected first migration %+v", migrations[0])
+			}
+		})
+	}
+}
+
+// TestEmbeddedMigrations keeps the shipped scripts loadable and splittable
+// TestEmbeddedMigrations mantiene los scripts incluidos cargables y divisibles
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := Load(migrationFiles)
+	if err != nil {
+		t.Fatalf("loading embedded migrations: %v", err)
+	}
+	for i, m := range migrations {
+		if m.Version != int64(i+1) {
+			t.Errorf("expected version %d, got %d_%s", i+1, m.Version, m.Name)
+		}
+		if len(SplitStatements(m.Up)) == 0 || len(SplitStatements(m.Down)) == 0 {
+			t.Errorf("%d_%s has an empty script", m.Version, m.Name)
+		}
+	}
+}
+
+// TestSplitStatements covers comments, quoted semicolons and escapes
+// TestSplitStatements cubre comentarios, punto y coma entre comillas y escapes
+func TestSplitStatements(t *testing.T) {
+	cases := []struct {
+		name     string
+		script   string
+		expected []string
+	}{
+		{
+			name:     "plain statements",
+			script:   "CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\n",
+			expected: []string{"CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"},
+		},
+		{
+			name:     "last statement without semicolon",
+			script:   "SELECT 1;\nSELECT 2",
+			expected: []string{"SELECT 1", "SELECT 2"},
+		},
+		{
+			name:     "comments are dropped",
+			script:   "-- a comment; with a semicolon\nSELECT 1; -- trailing\n-- only a comment\n",
+			expected: []string{"SELECT 1"},
+		},
+		{
+			name:     "quoted semicolons and dashes",
+			script:   "INSERT INTO t VALUES ('a;b', \"--c\");\nSELECT `x;y` FROM t;",
+			expected: []string{"INSERT INTO t VALUES ('a;b', \"--c\")", "SELECT `x;y` FROM t"},
+		},
+		{
+			name:     "escaped quote",
+			script:   `INSERT INTO t VALUES ('it\'s; fine');`,
+			expected: []string{`INSERT INTO t VALUES ('it\'s; fine')`},
+		},
+		{
+			name:     "empty script",
+			script:   " ;\n-- nothing\n;",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if statements := SplitStatements(tc.script); !slices.Equal(statements, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, statements)
+			}
+		})
+	}
+}
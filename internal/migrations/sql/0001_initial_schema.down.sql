@@ -0,0 +1,21 @@
+-- Elimina el esquema inicial en orden inverso / Drops the initial schema in reverse order
+
+DROP TABLE IF EXISTS `user_rol`;
+DROP TABLE IF EXISTS `purchase_orders`;
+DROP TABLE IF EXISTS `inbound_orders`;
+DROP TABLE IF EXISTS `product_batches`;
+DROP TABLE IF EXISTS `product_records`;
+DROP TABLE IF EXISTS `products`;
+DROP TABLE IF EXISTS `sections`;
+DROP TABLE IF EXISTS `products_types`;
+DROP TABLE IF EXISTS `employees`;
+DROP TABLE IF EXISTS `warehouse`;
+DROP TABLE IF EXISTS `carriers`;
+DROP TABLE IF EXISTS `sellers`;
+DROP TABLE IF EXISTS `localities`;
+DROP TABLE IF EXISTS `provinces`;
+DROP TABLE IF EXISTS `countries`;
+DROP TABLE IF EXISTS `buyers`;
+DROP TABLE IF EXISTS `order_status`;
+DROP TABLE IF EXISTS `users`;
+DROP TABLE IF EXISTS `rol`;
@@ -0,0 +1,227 @@
+-- Esquema inicial de productos_frescos / Initial schema for productos_frescos
+
+-- Creación de la tabla 'countries'
+CREATE TABLE `countries` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `country_name` VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`)
+);
+
+-- Creación de la tabla 'provinces'
+-- Si se elimina un país, se eliminarán todas sus provincias.
+CREATE TABLE `provinces` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `province_name` VARCHAR(255) NOT NULL,
+  `id_country_fk` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`id_country_fk`) REFERENCES `countries`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'localities'
+-- Si se elimina una provincia, se eliminarán todas sus localidades.
+CREATE TABLE `localities` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `locality_name` VARCHAR(255) NOT NULL,
+  `province_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`province_id`) REFERENCES `provinces`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'sellers'
+-- Si se elimina una localidad, se eliminarán los vendedores asociados.
+CREATE TABLE `sellers` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `cid` VARCHAR(255) NOT NULL,
+  `company_name` VARCHAR(255) NOT NULL,
+  `address` VARCHAR(255) NOT NULL,
+  `telephone` VARCHAR(255) NOT NULL,
+  `locality_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`locality_id`) REFERENCES `localities`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'buyers'
+CREATE TABLE `buyers` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `id_card_number` VARCHAR(255) NOT NULL,
+  `first_name` VARCHAR(255) NOT NULL,
+  `last_name` VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`)
+);
+
+-- Creación de la tabla 'warehouse'
+-- Si se elimina una localidad, se eliminarán los almacenes asociados.
+CREATE TABLE `warehouse` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `address` VARCHAR(255) NOT NULL,
+  `telephone` VARCHAR(255) NOT NULL,
+  `minimum_temperature` INT,
+  `minimum_capacity` INT,
+  `warehouse_code` VARCHAR(255) NOT NULL,
+  `locality_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`locality_id`) REFERENCES `localities`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'employees'
+-- Si se elimina un almacén, se eliminarán los empleados asociados.
+CREATE TABLE `employees` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `id_card_number` VARCHAR(255) NOT NULL,
+  `first_name` VARCHAR(255) NOT NULL,
+  `last_name` VARCHAR(255) NOT NULL,
+  `warehouse_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`warehouse_id`) REFERENCES `warehouse`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'products_types'
+CREATE TABLE `products_types` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `description` VARCHAR(255),
+  PRIMARY KEY (`id`)
+);
+
+-- Creación de la tabla 'sections'
+-- Si se elimina un almacén o un tipo de producto, se eliminarán las secciones relacionadas.
+CREATE TABLE `sections` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `section_number` VARCHAR(255) NOT NULL,
+  `current_capacity` INT,
+  `current_temperature` DECIMAL(19,2),
+  `maximum_capacity` INT,
+  `minimum_capacity` INT,
+  `minimum_temperature` DECIMAL(19,2),
+  `warehouse_id` INT NOT NULL,
+  `product_type_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`warehouse_id`) REFERENCES `warehouse`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`product_type_id`) REFERENCES `products_types`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'products'
+-- Si se elimina un tipo de producto o un vendedor, se eliminarán los productos asociados.
+CREATE TABLE `products` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `description` VARCHAR(255),
+  `expiration_rate` DECIMAL(19,2),
+  `freezing_rate` DECIMAL(19,2),
+  `height` DECIMAL(19,2),
+  `length` DECIMAL(19,2),
+  `net_weight` DECIMAL(19,2),
+  `product_code` VARCHAR(255) NOT NULL,
+  `recommended_freezing_temperature` DECIMAL(19,2),
+  `width` DECIMAL(19,2),
+  `product_type_id` INT,
+  `seller_id` INT,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`product_type_id`) REFERENCES `products_types`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`seller_id`) REFERENCES `sellers`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'product_records'
+-- Si se elimina un producto, se eliminarán sus registros.
+CREATE TABLE `product_records` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `last_update_date` DATETIME(6),
+  `purchase_price` DECIMAL(19,2),
+  `sale_price` DECIMAL(19,2),
+  `product_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`product_id`) REFERENCES `products`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'product_batches'
+-- Si se elimina un producto o una sección, se eliminarán los lotes de productos asociados.
+CREATE TABLE `product_batches` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `batch_number` VARCHAR(255) NOT NULL,
+  `current_quantity` INT,
+  `current_temperature` DECIMAL(19,2),
+  `due_date` DATETIME(6),
+  `initial_quantity` INT,
+  `manufacturing_date` DATETIME(6),
+  `manufacturing_hour` DATETIME(6),
+  `minimum_temperature` DECIMAL(19,2),
+  `product_id` INT NOT NULL,
+  `section_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`product_id`) REFERENCES `products`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'inbound_orders'
+-- Si se elimina un empleado, lote de producto o almacén, se eliminarán las órdenes de entrada relacionadas.
+CREATE TABLE `inbound_orders` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `order_date` DATETIME(6),
+  `order_number` VARCHAR(255) NOT NULL,
+  `employee_id` INT NOT NULL,
+  `product_batch_id` INT NOT NULL,
+  `warehouse_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`employee_id`) REFERENCES `employees`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`warehouse_id`) REFERENCES `warehouse`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'carriers'
+-- Si se elimina una localidad, se eliminarán los transportistas asociados.
+CREATE TABLE `carriers` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `cid` VARCHAR(255) NOT NULL,
+  `company_name` VARCHAR(255) NOT NULL,
+  `address` VARCHAR(255) NOT NULL,
+  `telephone` VARCHAR(255) NOT NULL,
+  `locality_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`locality_id`) REFERENCES `localities`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'order_status'
+CREATE TABLE `order_status` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `description` VARCHAR(255),
+  PRIMARY KEY (`id`)
+);
+
+-- Creación de la tabla 'purchase_orders'
+-- Si se elimina un comprador o un registro de producto, se eliminarán las órdenes de compra asociadas.
+CREATE TABLE `purchase_orders` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `order_number` VARCHAR(255) NOT NULL,
+  `order_date` DATETIME NOT NULL,
+  `tracking_code` VARCHAR(255),
+  `buyer_id` INT NOT NULL,
+  `product_record_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`buyer_id`) REFERENCES `buyers`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`product_record_id`) REFERENCES `product_records`(`id`) ON DELETE CASCADE
+);
+
+-- Creación de la tabla 'users'
+CREATE TABLE `users` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `username` VARCHAR(255) NOT NULL,
+  `password` VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`)
+);
+
+-- Creación de la tabla 'rol'
+CREATE TABLE `rol` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `rol_name` VARCHAR(255) NOT NULL,
+  `description` VARCHAR(255),
+  PRIMARY KEY (`id`)
+);
+
+-- Creación de la tabla 'user_rol'
+-- Si se elimina un usuario o un rol, se eliminará la relación en esta tabla.
+CREATE TABLE `user_rol` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `usuario_id` INT NOT NULL,
+  `rol_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (`usuario_id`) REFERENCES `users`(`id`) ON DELETE CASCADE,
+  FOREIGN KEY (`rol_id`) REFERENCES `rol`(`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,8 @@
+-- Los tipos con descripción repetida unificados al migrar no se restauran
+-- Product types with a repeated description merged on the way up are not restored
+ALTER TABLE `products_types` DROP INDEX `uq_products_types_description`;
+
+ALTER TABLE `products_types`
+  DROP COLUMN `maximum_temperature`,
+  DROP COLUMN `minimum_temperature`,
+  DROP COLUMN `storage_class`;
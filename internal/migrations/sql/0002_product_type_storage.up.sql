@@ -0,0 +1,58 @@
+-- Requisitos de almacenamiento de los tipos de producto
+-- Storage requirements of product types
+ALTER TABLE `products_types`
+  ADD COLUMN `storage_class` VARCHAR(16) NOT NULL DEFAULT 'ambient',
+  ADD COLUMN `minimum_temperature` DECIMAL(19,2) NOT NULL DEFAULT 0,
+  ADD COLUMN `maximum_temperature` DECIMAL(19,2) NOT NULL DEFAULT 25;
+
+-- Los tipos existentes toman la clase y el rango de las temperaturas de sus secciones y lotes, ampliado a la banda
+-- típica de la clase, así las secciones bajo cero existentes siguen validando. Los tipos sin datos quedan en ambient 0..25
+-- Existing types take the class and range of the temperatures of their sections and batches, widened to the typical
+-- band of the class, so existing sub-zero sections keep validating. Types without data stay ambient 0..25
+UPDATE `products_types` t
+JOIN (
+  SELECT `product_type_id`, MIN(`temperature`) AS `low`, MAX(`temperature`) AS `high`
+  FROM (
+    SELECT `product_type_id`, `current_temperature` AS `temperature` FROM `sections`
+    UNION ALL
+    SELECT `product_type_id`, `minimum_temperature` FROM `sections`
+    UNION ALL
+    SELECT p.`product_type_id`, b.`current_temperature` FROM `product_batches` b JOIN `products` p ON p.`id` = b.`product_id`
+    UNION ALL
+    SELECT p.`product_type_id`, b.`minimum_temperature` FROM `product_batches` b JOIN `products` p ON p.`id` = b.`product_id`
+  ) observed
+  WHERE `temperature` IS NOT NULL AND `product_type_id` IS NOT NULL
+  GROUP BY `product_type_id`
+) r ON r.`product_type_id` = t.`id`
+SET
+  t.`storage_class` = CASE WHEN r.`high` < 0 THEN 'frozen' WHEN r.`high` <= 8 THEN 'chilled' ELSE 'ambient' END,
+  t.`minimum_temperature` = CASE WHEN r.`high` < 0 THEN LEAST(r.`low`, -30) ELSE LEAST(r.`low`, 0) END,
+  t.`maximum_temperature` = CASE WHEN r.`high` < 0 THEN GREATEST(r.`high`, -15) WHEN r.`high` <= 8 THEN GREATEST(r.`high`, 8) ELSE GREATEST(r.`high`, 25) END;
+
+-- Las descripciones repetidas se unifican en el tipo de menor id antes de crear el índice único, moviendo sus productos y secciones
+-- Repeated descriptions are merged into the type with the lowest id before creating the unique index, moving their products and sections
+UPDATE `products` p
+JOIN `products_types` t ON t.`id` = p.`product_type_id`
+JOIN (SELECT `description`, MIN(`id`) AS `keep_id` FROM `products_types` WHERE `description` IS NOT NULL GROUP BY `description`) k ON k.`description` = t.`description`
+SET p.`product_type_id` = k.`keep_id`
+WHERE t.`id` <> k.`keep_id`;
+
+UPDATE `sections` s
+JOIN `products_types` t ON t.`id` = s.`product_type_id`
+JOIN (SELECT `description`, MIN(`id`) AS `keep_id` FROM `products_types` WHERE `description` IS NOT NULL GROUP BY `description`) k ON k.`description` = t.`description`
+SET s.`product_type_id` = k.`keep_id`
+WHERE t.`id` <> k.`keep_id`;
+
+-- The kept type widens its range to cover the merged ones / El tipo conservado amplía su rango para cubrir los unificados
+UPDATE `products_types` t
+JOIN (
+  SELECT `description`, MIN(`id`) AS `keep_id`, MIN(`minimum_temperature`) AS `low`, MAX(`maximum_temperature`) AS `high`
+  FROM `products_types` WHERE `description` IS NOT NULL GROUP BY `description` HAVING COUNT(*) > 1
+) k ON k.`keep_id` = t.`id`
+SET t.`minimum_temperature` = k.`low`, t.`maximum_temperature` = k.`high`;
+
+DELETE t FROM `products_types` t
+JOIN (SELECT `description`, MIN(`id`) AS `keep_id` FROM `products_types` WHERE `description` IS NOT NULL GROUP BY `description`) k ON k.`description` = t.`description`
+WHERE t.`id` <> k.`keep_id`;
+
+ALTER TABLE `products_types` ADD UNIQUE INDEX `uq_products_types_description` (`description`);
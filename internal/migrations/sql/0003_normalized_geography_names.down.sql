@@ -0,0 +1,8 @@
+ALTER TABLE `localities` DROP INDEX `idx_localities_normalized_name`;
+ALTER TABLE `localities` DROP COLUMN `normalized_name`;
+
+ALTER TABLE `provinces` DROP INDEX `idx_provinces_normalized_name`;
+ALTER TABLE `provinces` DROP COLUMN `normalized_name`;
+
+ALTER TABLE `countries` DROP INDEX `idx_countries_normalized_name`;
+ALTER TABLE `countries` DROP COLUMN `normalized_name`;
@@ -0,0 +1,18 @@
+-- Nombres normalizados (minúsculas, sin acentos) para buscar y evitar duplicados como "Cordoba" y "Córdoba"
+-- Normalized names (lowercase, no accents) to search and to avoid duplicates such as "Cordoba" and "Córdoba"
+-- La aplicación los completa al escribir; aquí se rellenan las filas existentes
+-- The application fills them on write; existing rows are backfilled here
+-- El backfill colapsa los espacios internos igual que tools.NormalizeName, para que coincida con lo que escribe la aplicación
+-- The backfill collapses inner whitespace like tools.NormalizeName, so it matches what the application writes
+
+ALTER TABLE `countries` ADD COLUMN `normalized_name` VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE `countries` SET `normalized_name` = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(LOWER(TRIM(REGEXP_REPLACE(`country_name`, '[[:space:]]+', ' '))), 'á', 'a'), 'à', 'a'), 'ä', 'a'), 'â', 'a'), 'ã', 'a'), 'é', 'e'), 'è', 'e'), 'ë', 'e'), 'ê', 'e'), 'í', 'i'), 'ì', 'i'), 'ï', 'i'), 'î', 'i'), 'ó', 'o'), 'ò', 'o'), 'ö', 'o'), 'ô', 'o'), 'õ', 'o'), 'ú', 'u'), 'ù', 'u'), 'ü', 'u'), 'û', 'u'), 'ñ', 'n'), 'ç', 'c');
+CREATE INDEX `idx_countries_normalized_name` ON `countries` (`normalized_name`);
+
+ALTER TABLE `provinces` ADD COLUMN `normalized_name` VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE `provinces` SET `normalized_name` = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(LOWER(TRIM(REGEXP_REPLACE(`province_name`, '[[:space:]]+', ' '))), 'á', 'a'), 'à', 'a'), 'ä', 'a'), 'â', 'a'), 'ã', 'a'), 'é', 'e'), 'è', 'e'), 'ë', 'e'), 'ê', 'e'), 'í', 'i'), 'ì', 'i'), 'ï', 'i'), 'î', 'i'), 'ó', 'o'), 'ò', 'o'), 'ö', 'o'), 'ô', 'o'), 'õ', 'o'), 'ú', 'u'), 'ù', 'u'), 'ü', 'u'), 'û', 'u'), 'ñ', 'n'), 'ç', 'c');
+CREATE INDEX `idx_provinces_normalized_name` ON `provinces` (`id_country_fk`, `normalized_name`);
+
+ALTER TABLE `localities` ADD COLUMN `normalized_name` VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE `localities` SET `normalized_name` = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(LOWER(TRIM(REGEXP_REPLACE(`locality_name`, '[[:space:]]+', ' '))), 'á', 'a'), 'à', 'a'), 'ä', 'a'), 'â', 'a'), 'ã', 'a'), 'é', 'e'), 'è', 'e'), 'ë', 'e'), 'ê', 'e'), 'í', 'i'), 'ì', 'i'), 'ï', 'i'), 'î', 'i'), 'ó', 'o'), 'ò', 'o'), 'ö', 'o'), 'ô', 'o'), 'õ', 'o'), 'ú', 'u'), 'ù', 'u'), 'ü', 'u'), 'û', 'u'), 'ñ', 'n'), 'ç', 'c');
+CREATE INDEX `idx_localities_normalized_name` ON `localities` (`province_id`, `normalized_name`);
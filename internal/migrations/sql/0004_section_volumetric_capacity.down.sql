@@ -0,0 +1,3 @@
+ALTER TABLE `sections`
+  DROP COLUMN `maximum_weight`,
+  DROP COLUMN `maximum_volume`;
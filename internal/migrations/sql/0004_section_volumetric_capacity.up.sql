@@ -0,0 +1,5 @@
+-- Límites opcionales de volumen (m³) y peso (kg) de las secciones; NULL significa sin límite
+-- Optional volume (m³) and weight (kg) limits of sections; NULL means unlimited
+ALTER TABLE `sections`
+  ADD COLUMN `maximum_volume` DECIMAL(19,4) NULL,
+  ADD COLUMN `maximum_weight` DECIMAL(19,2) NULL;
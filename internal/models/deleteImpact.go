@@ -0,0 +1,16 @@
+package models
+
+// DeleteImpact holds the number of dependent rows that a cascading delete would remove
+// DeleteImpact contiene la cantidad de filas dependientes que un borrado en cascada eliminaría
+type DeleteImpact struct {
+	Sections       int
+	ProductBatches int
+	Employees      int
+	InboundOrders  int
+}
+
+// Total returns the sum of all dependent rows
+// Total retorna la suma de todas las filas dependientes
+func (d DeleteImpact) Total() int {
+	return d.Sections + d.ProductBatches + d.Employees + d.InboundOrders
+}
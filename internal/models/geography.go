@@ -0,0 +1,63 @@
+package models
+
+// Country - Top level of the geographic hierarchy
+// Country - Nivel superior de la jerarquía geográfica
+type Country struct {
+	Id          int
+	CountryName string
+}
+
+// Province - Second level of the geographic hierarchy, carrying its country name for display
+// Province - Segundo nivel de la jerarquía geográfica, con el nombre de su país para mostrar
+type Province struct {
+	Id           int
+	ProvinceName string
+	CountryId    int
+	CountryName  string
+}
+
+// CountryNode, ProvinceNode and LocalityNode form the geographic tree
+// CountryNode, ProvinceNode y LocalityNode forman el árbol geográfico
+type CountryNode struct {
+	Id          int
+	CountryName string
+	Provinces   []ProvinceNode
+}
+
+type ProvinceNode struct {
+	Id           int
+	ProvinceName string
+	Localities   []LocalityNode
+}
+
+type LocalityNode struct {
+	Id           int
+	LocalityName string
+}
+
+// GeographyMergeResult - Summary of a merge: provinces and localities moved or merged into the target and rows repointed
+// GeographyMergeResult - Resumen de una fusión: provincias y localidades movidas o fusionadas en el destino y filas reasignadas
+type GeographyMergeResult struct {
+	SourceId   int
+	TargetId   int
+	Provinces  int
+	Localities int
+	Sellers    int
+	Carriers   int
+	Warehouses int
+}
+
+// Levels a locality search can match on / Niveles en los que puede coincidir una búsqueda de localidades
+const (
+	GeographyLevelLocality = "locality"
+	GeographyLevelProvince = "province"
+	GeographyLevelCountry  = "country"
+)
+
+// LocalityMatch - Locality found by a search, with the level that matched and its edit distance (0 for exact or prefix matches)
+// LocalityMatch - Localidad encontrada por una búsqueda, con el nivel que coincidió y su distancia de edición (0 para coincidencias exactas o por prefijo)
+type LocalityMatch struct {
+	Locality  Locality
+	MatchedOn string
+	Distance  int
+}
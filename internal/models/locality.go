@@ -5,4 +5,42 @@ type Locality struct {
 	LocalityName string `json:"locality_name"`
 	ProvinceName string `json:"province_name"`
 	CountryName  string `json:"country_name"`
+	ProvinceId   int    `json:"province_id,omitempty"`
+	CountryId    int    `json:"country_id,omitempty"`
+}
+
+// LocalityCounts - One row of the geographic hierarchy with the rows pointing at the locality
+// Provinces and countries without localities come with zero ids on the missing levels
+// Buyers join these counts once they are linked to a locality
+// LocalityCounts - Una fila de la jerarquía geográfica con las filas que apuntan a la localidad
+// Las provincias y países sin localidades vienen con ids en cero en los niveles faltantes
+// Los compradores se sumarán a estos conteos cuando estén vinculados a una localidad
+type LocalityCounts struct {
+	CountryId    int
+	CountryName  string
+	ProvinceId   int
+	ProvinceName string
+	LocalityId   int
+	LocalityName string
+	Sellers      int
+	Carriers     int
+	Warehouses   int
+}
+
+// LocalityDashboard - Counts for a locality, or rolled up for a province or country
+// Parent fields are set only for the levels above Level
+// LocalityDashboard - Conteos de una localidad, o acumulados por provincia o país
+// Los campos de los padres solo se completan para los niveles por encima de Level
+type LocalityDashboard struct {
+	Level        string
+	Id           int
+	Name         string
+	ProvinceId   int
+	ProvinceName string
+	CountryId    int
+	CountryName  string
+	Localities   int
+	Sellers      int
+	Carriers     int
+	Warehouses   int
 }
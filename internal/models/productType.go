@@ -0,0 +1,27 @@
+package models
+
+// Storage classes of a product type / Clases de almacenamiento de un tipo de producto
+const (
+	StorageClassFrozen  = "frozen"
+	StorageClassChilled = "chilled"
+	StorageClassAmbient = "ambient"
+)
+
+// StorageClasses lists every valid storage class / StorageClasses lista todas las clases de almacenamiento válidas
+var StorageClasses = []string{StorageClassFrozen, StorageClassChilled, StorageClassAmbient}
+
+// ProductType - Category of products with the storage conditions they require
+// ProductType - Categoría de productos con las condiciones de almacenamiento que requieren
+type ProductType struct {
+	Id                 int
+	Description        string
+	StorageClass       string
+	MinimumTemperature float64
+	MaximumTemperature float64
+}
+
+// AcceptsTemperature reports whether the temperature is inside the required range, bounds included
+// AcceptsTemperature indica si la temperatura está dentro del rango requerido, límites incluidos
+func (p ProductType) AcceptsTemperature(temperature float64) bool {
+	return temperature >= p.MinimumTemperature && temperature <= p.MaximumTemperature
+}
@@ -1,13 +1,34 @@
 package models
 
 type Section struct {
-	Id                 int     `json:"id"`
-	SectionNumber      string  `json:"section_number"`
-	CurrentCapacity    int     `json:"current_capacity"`
-	CurrentTemperature float64 `json:"current_temperature"`
-	MaximumCapacity    int     `json:"maximum_capacity"`
-	MinimumCapacity    int     `json:"minimum_capacity"`
-	MinimumTemperature float64 `json:"minimum_temperature"`
-	ProductTypeID      int     `json:"product_type_id"`
-	WarehouseID        int     `json:"warehouse_id"`
+	Id                 int      `json:"id"`
+	SectionNumber      string   `json:"section_number"`
+	CurrentCapacity    int      `json:"current_capacity"`
+	CurrentTemperature float64  `json:"current_temperature"`
+	MaximumCapacity    int      `json:"maximum_capacity"`
+	MinimumCapacity    int      `json:"minimum_capacity"`
+	MinimumTemperature float64  `json:"minimum_temperature"`
+	MaximumVolume      *float64 `json:"maximum_volume,omitempty"` // Optional limit in m³ / Límite opcional en m³
+	MaximumWeight      *float64 `json:"maximum_weight,omitempty"` // Optional limit in kg / Límite opcional en kg
+	ProductTypeID      int      `json:"product_type_id"`
+	WarehouseID        int      `json:"warehouse_id"`
+}
+
+// SectionOccupancy - Space taken by the batches of a section, in units, m³ and kg
+// Volume and weight come from the product dimensions and net weight times the batch quantity
+// SectionOccupancy - Espacio ocupado por los lotes de una sección, en unidades, m³ y kg
+// El volumen y el peso salen de las dimensiones y el peso neto del producto por la cantidad del lote
+type SectionOccupancy struct {
+	Units  int
+	Volume float64
+	Weight float64
+}
+
+// Add - Returns the occupancy after placing quantity units of the product
+// Add - Retorna la ocupación luego de ubicar quantity unidades del producto
+func (o SectionOccupancy) Add(product Product, quantity int) SectionOccupancy {
+	o.Units += quantity
+	o.Volume += product.Width * product.Height * product.Length * float64(quantity)
+	o.Weight += product.NetWeight * float64(quantity)
+	return o
 }
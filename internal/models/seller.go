@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type Seller struct {
 	Id          int    `json:"id"`
 	CID         string `json:"cid"`
@@ -8,3 +10,18 @@ type Seller struct {
 	Telephone   string `json:"telephone"`
 	LocalityID  int    `json:"locality_id"`
 }
+
+// SellerReport - Performance figures of a seller; sales only count purchase orders placed from From to To, both days included
+// A nil bound leaves that side of the range open
+// SellerReport - Cifras de desempeño de un vendedor; las ventas solo cuentan órdenes de compra realizadas desde From hasta To, ambos días incluidos
+// Un límite nil deja abierto ese lado del rango
+type SellerReport struct {
+	SellerId      int
+	CompanyName   string
+	ProductsCount int
+	BatchStock    int     // Sum of current_quantity of the seller's product batches / Suma de current_quantity de los lotes del vendedor
+	UnitsSold     int     // One unit per purchase order / Una unidad por orden de compra
+	Revenue       float64 // Sum of the sale price of the ordered product records / Suma del precio de venta de los registros ordenados
+	From          *time.Time
+	To            *time.Time
+}
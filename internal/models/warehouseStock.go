@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// WarehouseStockRow - Batches of one product stored in one section of the warehouse
+// Sections without batches come with a zero ProductId and no due date
+// WarehouseStockRow - Lotes de un producto guardados en una sección del almacén
+// Las secciones sin lotes vienen con ProductId en cero y sin fecha de vencimiento
+type WarehouseStockRow struct {
+	SectionId          int
+	SectionNumber      string
+	MaximumCapacity    int
+	MaximumVolume      *float64
+	MaximumWeight      *float64
+	ProductId          int
+	ProductCode        string
+	ProductDescription string
+	Batches            int
+	Quantity           int
+	Volume             float64
+	Weight             float64
+	EarliestDueDate    *time.Time
+}
+
+// WarehouseStock - Stock of a warehouse rolled up per section and per product
+// WarehouseStock - Stock de un almacén acumulado por sección y por producto
+type WarehouseStock struct {
+	WarehouseId     int
+	WarehouseCode   string
+	Quantity        int
+	EarliestDueDate *time.Time
+	Sections        []SectionStock
+}
+
+// SectionStock - Stock of a section; Utilization is the percentage of MaximumCapacity in use
+// Volume and weight utilization are only set when the section declares those limits
+// SectionStock - Stock de una sección; Utilization es el porcentaje de MaximumCapacity en uso
+// La utilización de volumen y peso solo se completa cuando la sección declara esos límites
+type SectionStock struct {
+	SectionId         int
+	SectionNumber     string
+	MaximumCapacity   int
+	MaximumVolume     *float64
+	MaximumWeight     *float64
+	Quantity          int
+	Volume            float64
+	Weight            float64
+	Utilization       float64
+	VolumeUtilization *float64
+	WeightUtilization *float64
+	EarliestDueDate   *time.Time
+	Products          []ProductStock
+}
+
+// ProductStock - Stock of a product inside a section
+// ProductStock - Stock de un producto dentro de una sección
+type ProductStock struct {
+	ProductId       int
+	ProductCode     string
+	Description     string
+	Batches         int
+	Quantity        int
+	Volume          float64
+	Weight          float64
+	EarliestDueDate *time.Time
+}
+
+// WarehouseStockTotal - Units stored in a warehouse across all its sections
+// WarehouseStockTotal - Unidades guardadas en un almacén sumando todas sus secciones
+type WarehouseStockTotal struct {
+	WarehouseId   int
+	WarehouseCode string
+	Quantity      int
+}
@@ -0,0 +1,275 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+)
+
+// Route documents one endpoint of routes.SetupRoutes
+// Request and Response are zero values of the payload types; successful responses are wrapped
+// in {"data": ...} unless Bare is set, and a nil Response means the endpoint answers without body
+// Root marks endpoints served at the site root instead of under /api/v1
+// Route documenta un endpoint de routes.SetupRoutes
+// Request y Response son valores cero de los tipos del payload; las respuestas exitosas se envuelven
+// en {"data": ...} salvo que Bare esté activo, y un Response nil significa que el endpoint responde sin cuerpo
+// Root marca los endpoints servidos en la raíz del sitio en lugar de bajo /api/v1
+type Route struct {
+	Method   string
+	Path     string
+	Tag      string
+	Summary  string
+	Query    []Param
+	Request  any
+	Response any
+	Status   int
+	Bare     bool
+	Root     bool
+}
+
+// Param documents a query parameter / Param documenta un parámetro de query
+type Param struct {
+	Name        string
+	Type        string
+	Format      string // like date for YYYY-MM-DD strings / como date para strings YYYY-MM-DD
+	Description string
+}
+
+// Document is the subset of OpenAPI 3 the API publishes
+// Document es el subconjunto de OpenAPI 3 que publica la API
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Servers    []Server                         `json:"servers"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+type Operation struct {
+	Tags        []string             `json:"tags,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Servers     []Server             `json:"servers,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Required    bool    `json:"required,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// Build assembles the document for routes, deriving the component schemas from the payload types
+// Build arma el documento para routes, derivando los schemas de componentes de los tipos del payload
+func Build(routes []Route) *Document {
+	b := builder{schemas: map[string]*Schema{}, names: map[reflect.Type]string{}}
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: "Meli Frescos API", Version: "v1"},
+		Servers: []Server{{URL: "/api/v1"}},
+		Paths:   map[string]map[string]*Operation{},
+	}
+
+	errorSchema := b.schema(reflect.TypeOf(responses.ErrorResponse{}))
+	for _, route := range routes {
+		op := &Operation{Summary: route.Summary, Responses: map[string]*Response{}}
+		if route.Tag != "" {
+			op.Tags = []string{route.Tag}
+		}
+		if route.Root {
+			op.Servers = []Server{{URL: "/"}}
+		}
+
+		for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+			op.Parameters = append(op.Parameters, Parameter{Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "integer"}})
+		}
+		for _, param := range route.Query {
+			op.Parameters = append(op.Parameters, Parameter{Name: param.Name, In: "query", Description: param.Description, Schema: &Schema{Type: param.Type, Format: param.Format}})
+		}
+
+		if route.Request != nil {
+			op.RequestBody = &RequestBody{Required: true, Content: jsonContent(b.schema(reflect.TypeOf(route.Request)))}
+		}
+
+		status := route.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		success := &Response{Description: http.StatusText(status)}
+		if route.Response != nil {
+			schema := b.schema(reflect.TypeOf(route.Response))
+			if !route.Bare {
+				schema = &Schema{Type: "object", Properties: map[string]*Schema{"data": schema}}
+			}
+			success.Content = jsonContent(schema)
+		}
+		op.Responses[strconv.Itoa(status)] = success
+		op.Responses["default"] = &Response{Description: "Error", Content: jsonContent(errorSchema)}
+
+		if doc.Paths[route.Path] == nil {
+			doc.Paths[route.Path] = map[string]*Operation{}
+		}
+		doc.Paths[route.Path][strings.ToLower(route.Method)] = op
+	}
+
+	doc.Components.Schemas = b.schemas
+	return doc
+}
+
+// Handler serves doc as JSON / Handler sirve doc como JSON
+func Handler(doc *Document) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response.JSON(w, http.StatusOK, doc)
+	}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+// builder keeps the named struct schemas already generated / builder guarda los schemas de structs con nombre ya generados
+type builder struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schema maps a Go type to its schema following encoding/json rules; named structs become components
+// schema traduce un tipo Go a su schema siguiendo las reglas de encoding/json; los structs con nombre pasan a ser componentes
+func (b *builder) schema(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem := b.schema(t.Elem())
+		if elem.Ref != "" {
+			return elem
+		}
+		elem.Nullable = true
+		return elem
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: b.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schema(t.Elem())}
+	case reflect.Struct:
+		if t == timeType {
+			return &Schema{Type: "string", Format: "date-time"}
+		}
+		if t.Name() == "" {
+			return b.object(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + b.component(t)}
+	default:
+		return &Schema{}
+	}
+}
+
+// component registers a named struct once, prefixing the package name when two packages share a type name
+// component registra un struct con nombre una sola vez, anteponiendo el paquete cuando dos paquetes comparten el nombre
+func (b *builder) component(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+
+	name := strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+	if _, taken := b.schemas[name]; taken {
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		name = strings.ToUpper(pkg[:1]) + pkg[1:] + name
+	}
+	b.names[t] = name
+	b.schemas[name] = &Schema{}
+	*b.schemas[name] = *b.object(t)
+	return name
+}
+
+func (b *builder) object(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	b.fields(t, schema)
+	sort.Strings(schema.Required)
+	return schema
+}
+
+// fields adds the exported fields of t to schema, flattening embedded structs like encoding/json does
+// fields agrega los campos exportados de t al schema, aplanando los structs embebidos como hace encoding/json
+func (b *builder) fields(t reflect.Type, schema *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			b.fields(field.Type, schema)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties[name] = b.schema(field.Type)
+		if !strings.Contains(options, "omitempty") && field.Type.Kind() != reflect.Pointer {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
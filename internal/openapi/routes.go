@@ -0,0 +1,138 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+// deleteParams are the query parameters shared by the deletes with cascade
+// deleteParams son los parámetros de query compartidos por los borrados en cascada
+var deleteParams = []Param{
+	{Name: "dryRun", Type: "boolean", Description: "Only report the dependent records"},
+	{Name: "force", Type: "boolean", Description: "Delete the dependent records in cascade"},
+}
+
+// idFilter is the optional ?id of the reports / idFilter es el ?id opcional de los reportes
+func idFilter(entity string) []Param {
+	return []Param{{Name: "id", Type: "integer", Description: "Restrict the report to one " + entity}}
+}
+
+// apiStatus is the body of GET / / apiStatus es el cuerpo de GET /
+type apiStatus struct {
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
+
+// Routes documents every endpoint registered in routes.SetupRoutes, paths are relative to /api/v1
+// routes_test fails when a route is registered without being listed here
+// Routes documenta cada endpoint registrado en routes.SetupRoutes, las rutas son relativas a /api/v1
+// routes_test falla cuando se registra una ruta sin listarla aquí
+var Routes = []Route{
+	{Method: http.MethodGet, Path: "/", Tag: "health", Summary: "API status", Response: apiStatus{}, Bare: true},
+	{Method: http.MethodGet, Path: "/openapi.json", Tag: "health", Summary: "This OpenAPI document", Response: map[string]any{}, Bare: true},
+	{Method: http.MethodGet, Path: "/healthz", Tag: "health", Summary: "Liveness probe", Response: responses.HealthResponse{}, Bare: true, Root: true},
+	{Method: http.MethodGet, Path: "/readyz", Tag: "health", Summary: "Readiness probe: database ping and pending migrations; 503 when a check fails", Response: responses.ReadinessResponse{}, Bare: true, Root: true},
+	{Method: http.MethodGet, Path: "/version", Tag: "health", Summary: "Commit and build time of the running binary", Response: responses.VersionResponse{}, Bare: true, Root: true},
+	{Method: http.MethodGet, Path: "/metrics", Tag: "health", Summary: "Prometheus metrics in the text exposition format", Root: true},
+	{Method: http.MethodGet, Path: "/database/stats", Tag: "health", Summary: "Connection pool statistics; 404 when storage is memory", Response: responses.DatabaseStatsResponse{}},
+
+	// Employees / Empleados
+	{Method: http.MethodGet, Path: "/employee", Tag: "employees", Summary: "List employees", Response: []*responses.EmployeeResponse{}},
+	{Method: http.MethodGet, Path: "/employee/{id}", Tag: "employees", Summary: "Get an employee", Response: &responses.EmployeeResponse{}},
+	{Method: http.MethodPost, Path: "/employee", Tag: "employees", Summary: "Create an employee", Request: requests.EmployeeRequest{}, Response: &responses.EmployeeResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPatch, Path: "/employee/{id}", Tag: "employees", Summary: "Update an employee", Request: requests.EmployeeRequest{}, Response: &responses.EmployeeResponse{}},
+	{Method: http.MethodDelete, Path: "/employee/{id}", Tag: "employees", Summary: "Delete an employee", Status: http.StatusNoContent},
+	{Method: http.MethodGet, Path: "/employee/reportInboundOrders", Tag: "employees", Summary: "Inbound orders per employee", Query: idFilter("employee"), Response: []models.InboundOrderReport{}},
+
+	// Buyers / Compradores
+	{Method: http.MethodGet, Path: "/buyers", Tag: "buyers", Summary: "List buyers", Response: []*responses.BuyerResponse{}},
+	{Method: http.MethodGet, Path: "/buyers/{id}", Tag: "buyers", Summary: "Get a buyer", Response: &responses.BuyerResponse{}},
+	{Method: http.MethodDelete, Path: "/buyers/{id}", Tag: "buyers", Summary: "Delete a buyer", Status: http.StatusNoContent},
+	{Method: http.MethodPost, Path: "/buyers", Tag: "buyers", Summary: "Create a buyer", Request: requests.BuyerRequest{}, Response: &responses.BuyerResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPatch, Path: "/buyers/{id}", Tag: "buyers", Summary: "Update a buyer", Request: requests.BuyerRequest{}, Response: &responses.BuyerResponse{}},
+	{Method: http.MethodGet, Path: "/buyers/reportPurchaseOrders", Tag: "buyers", Summary: "Purchase orders per buyer", Query: idFilter("buyer"), Response: []models.PurchaseOrderReport{}},
+
+	// Warehouses / Almacenes
+	{Method: http.MethodGet, Path: "/warehouse/{id}", Tag: "warehouses", Summary: "Get a warehouse", Response: responses.WarehouseResponse{}},
+	{Method: http.MethodGet, Path: "/warehouse/{id}/stock", Tag: "warehouses", Summary: "Stock of a warehouse per section and product", Response: responses.WarehouseStockResponse{}},
+	{Method: http.MethodGet, Path: "/warehouse", Tag: "warehouses", Summary: "List warehouses", Response: []responses.WarehouseResponse{}},
+	{Method: http.MethodPost, Path: "/warehouse", Tag: "warehouses", Summary: "Create a warehouse", Request: requests.WarehouseRequest{}, Response: responses.WarehouseResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPatch, Path: "/warehouse/{id}", Tag: "warehouses", Summary: "Update a warehouse", Request: requests.WarehousePatchRequest{}, Response: responses.WarehouseResponse{}},
+	{Method: http.MethodDelete, Path: "/warehouse/{id}", Tag: "warehouses", Summary: "Delete a warehouse; with dryRun=true answers 200 with the dependent records", Query: deleteParams, Status: http.StatusNoContent},
+
+	// Product types / Tipos de producto
+	{Method: http.MethodGet, Path: "/productTypes", Tag: "productTypes", Summary: "List product types", Response: []responses.ProductTypeResponse{}},
+	{Method: http.MethodGet, Path: "/productTypes/{id}", Tag: "productTypes", Summary: "Get a product type", Response: responses.ProductTypeResponse{}},
+	{Method: http.MethodPost, Path: "/productTypes", Tag: "productTypes", Summary: "Create a product type", Request: requests.ProductTypeRequest{}, Response: responses.ProductTypeResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPatch, Path: "/productTypes/{id}", Tag: "productTypes", Summary: "Update a product type", Request: requests.ProductTypePatchRequest{}, Response: responses.ProductTypeResponse{}},
+	{Method: http.MethodDelete, Path: "/productTypes/{id}", Tag: "productTypes", Summary: "Delete a product type", Status: http.StatusNoContent},
+
+	// Sellers / Vendedores
+	{Method: http.MethodGet, Path: "/sellers", Tag: "sellers", Summary: "List sellers", Response: []responses.SellerResponse{}},
+	{Method: http.MethodGet, Path: "/sellers/{id}", Tag: "sellers", Summary: "Get a seller", Response: responses.SellerResponse{}},
+	{Method: http.MethodGet, Path: "/sellers/{id}/products", Tag: "sellers", Summary: "Products of a seller", Response: []models.Product{}},
+	{Method: http.MethodGet, Path: "/sellers/{id}/report", Tag: "sellers", Summary: "Sales report of a seller", Query: []Param{
+		{Name: "from", Type: "string", Format: "date", Description: "Start date, YYYY-MM-DD"},
+		{Name: "to", Type: "string", Format: "date", Description: "End date, YYYY-MM-DD"},
+	}, Response: responses.SellerReportResponse{}},
+	{Method: http.MethodPost, Path: "/sellers", Tag: "sellers", Summary: "Create a seller", Request: requests.SellerRequest{}, Response: responses.SellerResponse{}},
+	{Method: http.MethodPatch, Path: "/sellers/{id}", Tag: "sellers", Summary: "Update a seller", Request: requests.SellerRequest{}, Response: responses.SellerResponse{}},
+	{Method: http.MethodDelete, Path: "/sellers/{id}", Tag: "sellers", Summary: "Delete a seller", Status: http.StatusNoContent},
+
+	// Sections / Secciones
+	{Method: http.MethodGet, Path: "/sections", Tag: "sections", Summary: "List sections", Response: []*responses.SectionResponse{}},
+	{Method: http.MethodGet, Path: "/sections/{id}", Tag: "sections", Summary: "Get a section", Response: &responses.SectionResponse{}},
+	{Method: http.MethodGet, Path: "/sections/reportProducts", Tag: "sections", Summary: "Products per section; with id answers a single report", Query: idFilter("section"), Response: []responses.SectionProductsReportResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPost, Path: "/sections", Tag: "sections", Summary: "Create a section", Request: requests.SectionRequest{}, Response: &responses.SectionResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPatch, Path: "/sections/{id}", Tag: "sections", Summary: "Update a section", Request: requests.SectionRequest{}, Response: &responses.SectionResponse{}},
+	{Method: http.MethodDelete, Path: "/sections/{id}", Tag: "sections", Summary: "Delete a section; with dryRun=true answers 200 with the dependent records", Query: deleteParams, Status: http.StatusNoContent},
+
+	// Products / Productos
+	{Method: http.MethodGet, Path: "/products", Tag: "products", Summary: "List products", Response: []models.Product{}},
+	{Method: http.MethodGet, Path: "/products/{id}", Tag: "products", Summary: "Get a product", Response: &responses.ProductResponse{}},
+	{Method: http.MethodPost, Path: "/products", Tag: "products", Summary: "Create a product", Request: requests.ProductRequest{}, Response: &responses.ProductResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPatch, Path: "/products/{id}", Tag: "products", Summary: "Update a product", Request: requests.ProductRequest{}, Response: &responses.ProductResponse{}},
+	{Method: http.MethodDelete, Path: "/products/{id}", Tag: "products", Summary: "Delete a product", Status: http.StatusNoContent},
+	{Method: http.MethodGet, Path: "/products/reportRecords", Tag: "products", Summary: "Records per product", Query: idFilter("product"), Response: []*models.ProductRecordReport{}},
+
+	// Product batches and records / Lotes y registros de producto
+	{Method: http.MethodPost, Path: "/productBatches", Tag: "productBatches", Summary: "Create a product batch", Request: requests.ProductBatchRequest{}, Response: &responses.ProductBatchResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPost, Path: "/productRecords", Tag: "productRecords", Summary: "Create a product record", Request: requests.ProductRecordRequest{}, Response: &responses.ProductRecordResponse{}, Status: http.StatusCreated},
+
+	// Purchase and inbound orders / Órdenes de compra y de entrada
+	{Method: http.MethodGet, Path: "/purchaseOrders", Tag: "purchaseOrders", Summary: "List purchase orders", Response: []*responses.PurchaseOrderResponse{}},
+	{Method: http.MethodPost, Path: "/purchaseOrders", Tag: "purchaseOrders", Summary: "Create a purchase order", Request: requests.PurchaseOrderRequest{}, Response: &responses.PurchaseOrderResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPost, Path: "/inboundOrders", Tag: "inboundOrders", Summary: "Create an inbound order", Request: requests.InboundOrderRequest{}, Response: &responses.InboundOrderResponse{}, Status: http.StatusCreated},
+
+	// Geography / Geografía
+	{Method: http.MethodGet, Path: "/countries", Tag: "geography", Summary: "List countries", Response: []responses.CountryResponse{}},
+	{Method: http.MethodGet, Path: "/countries/tree", Tag: "geography", Summary: "Countries with their provinces and localities", Response: []responses.CountryNodeResponse{}},
+	{Method: http.MethodGet, Path: "/countries/{id}", Tag: "geography", Summary: "Get a country", Response: responses.CountryResponse{}},
+	{Method: http.MethodPatch, Path: "/countries/{id}", Tag: "geography", Summary: "Update a country", Request: requests.CountryPatchRequest{}, Response: responses.CountryResponse{}},
+	{Method: http.MethodPost, Path: "/countries/{id}/merge", Tag: "geography", Summary: "Merge a country into another", Request: requests.GeographyMergeRequest{}, Response: responses.GeographyMergeResponse{}},
+	{Method: http.MethodGet, Path: "/provinces", Tag: "geography", Summary: "List provinces", Query: []Param{{Name: "country_id", Type: "integer", Description: "Only provinces of this country"}}, Response: []responses.ProvinceResponse{}},
+	{Method: http.MethodGet, Path: "/provinces/{id}", Tag: "geography", Summary: "Get a province", Response: responses.ProvinceResponse{}},
+	{Method: http.MethodPatch, Path: "/provinces/{id}", Tag: "geography", Summary: "Update a province", Request: requests.ProvincePatchRequest{}, Response: responses.ProvinceResponse{}},
+	{Method: http.MethodPost, Path: "/provinces/{id}/merge", Tag: "geography", Summary: "Merge a province into another", Request: requests.GeographyMergeRequest{}, Response: responses.GeographyMergeResponse{}},
+	{Method: http.MethodGet, Path: "/localities", Tag: "geography", Summary: "List localities", Query: []Param{{Name: "province_id", Type: "integer", Description: "Only localities of this province"}}, Response: []responses.LocalityResponse{}},
+	{Method: http.MethodPost, Path: "/localities", Tag: "geography", Summary: "Create a locality", Request: requests.LocalityRequest{}, Response: models.Locality{}},
+	{Method: http.MethodGet, Path: "/localities/reportSellers", Tag: "geography", Summary: "Sellers per locality", Query: idFilter("locality"), Response: []responses.LocalitySellerReport{}},
+	{Method: http.MethodGet, Path: "/localities/reportCarriers", Tag: "geography", Summary: "Carriers per locality", Query: idFilter("locality"), Response: []responses.LocalityCarryReport{}},
+	{Method: http.MethodGet, Path: "/localities/dashboard", Tag: "geography", Summary: "Sellers, carriers and warehouses per locality, province or country", Query: []Param{
+		{Name: "level", Type: "string", Description: "locality, province or country"},
+		{Name: "id", Type: "integer", Description: "Keep a single row"},
+	}, Response: []responses.LocalityDashboardResponse{}},
+	{Method: http.MethodGet, Path: "/localities/search", Tag: "geography", Summary: "Search localities by locality, province or country name", Query: []Param{
+		{Name: "q", Type: "string", Description: "Text to search"},
+		{Name: "limit", Type: "integer", Description: "Maximum results, 1 to 100"},
+	}, Response: []responses.LocalitySearchResponse{}},
+	{Method: http.MethodGet, Path: "/localities/{id}", Tag: "geography", Summary: "Get a locality", Response: responses.LocalityResponse{}},
+	{Method: http.MethodPatch, Path: "/localities/{id}", Tag: "geography", Summary: "Update a locality", Request: requests.LocalityPatchRequest{}, Response: responses.LocalityResponse{}},
+	{Method: http.MethodPost, Path: "/localities/{id}/merge", Tag: "geography", Summary: "Merge a locality into another", Request: requests.GeographyMergeRequest{}, Response: responses.GeographyMergeResponse{}},
+
+	// Carriers / Transportistas
+	{Method: http.MethodPost, Path: "/carriers", Tag: "carriers", Summary: "Create a carrier", Request: requests.CarryRequest{}, Response: responses.CreateCarryResponse{}, Status: http.StatusCreated},
+}
@@ -0,0 +1,275 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+)
+
+// Validation errors raised by the middleware; their codes have a translated message in the i18n catalog
+// Errores de validación del middleware; sus códigos tienen un mensaje traducido en el catálogo de i18n
+var (
+	errTypeInvalid  = validation.NewError("validation_type_invalid", "must be of type {{.type}}")
+	errFieldUnknown = validation.NewError("validation_field_unknown", "is not a known field")
+	errDateInvalid  = validation.NewError("validation_date_invalid", "must be a valid date")
+)
+
+// ErrorWriter writes the error envelope for a rejected request / ErrorWriter escribe el sobre de error de una solicitud rechazada
+type ErrorWriter func(w http.ResponseWriter, r *http.Request, err error)
+
+// Validator checks path params, query params and JSON bodies against doc before the handler runs
+// Malformed params or bodies are bad requests; bodies with wrong types or unknown fields fail with
+// per field validation errors. Requests for paths doc does not describe pass through untouched
+// Validator verifica los parámetros de ruta, de query y los cuerpos JSON contra doc antes de ejecutar el handler
+// Los parámetros o cuerpos mal formados son solicitudes inválidas; los cuerpos con tipos erróneos o campos
+// desconocidos fallan con errores de validación por campo. Las rutas que doc no describe pasan sin cambios
+func Validator(doc *Document, writeError ErrorWriter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			op, pathParams := doc.match(r.Method, r.URL.Path)
+			if op == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := doc.validateParams(op, pathParams, r); err != nil {
+				writeError(w, r, err)
+				return
+			}
+			if op.RequestBody != nil {
+				body, err := doc.validateBody(op, r)
+				if err != nil {
+					writeError(w, r, err)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// match finds the operation for method and a request path, preferring literal segments over {params}
+// so /localities/search wins over /localities/{id}
+// match busca la operación para method y una ruta de la solicitud, prefiriendo segmentos literales sobre {params}
+// así /localities/search gana sobre /localities/{id}
+func (d *Document) match(method, requestPath string) (*Operation, map[string]string) {
+	var (
+		best       *Operation
+		bestParams map[string]string
+		bestScore  = -1
+	)
+	for template, operations := range d.Paths {
+		op, ok := operations[strings.ToLower(method)]
+		if !ok {
+			continue
+		}
+		segments, ok := d.relativeSegments(op, requestPath)
+		if !ok {
+			continue
+		}
+		templateSegments := strings.Split(template, "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+
+		params, score := map[string]string{}, 0
+		for i, segment := range templateSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params[segment[1:len(segment)-1]] = segments[i]
+				continue
+			}
+			if segment != segments[i] {
+				score = -1
+				break
+			}
+			score++
+		}
+		if score > bestScore {
+			best, bestParams, bestScore = op, params, score
+		}
+	}
+	return best, bestParams
+}
+
+// relativeSegments splits requestPath relative to the server of op, or of the document when op has none
+// relativeSegments divide requestPath relativo al server de op, o al del documento cuando op no tiene uno
+func (d *Document) relativeSegments(op *Operation, requestPath string) ([]string, bool) {
+	servers := d.Servers
+	if len(op.Servers) > 0 {
+		servers = op.Servers
+	}
+
+	path := requestPath
+	if len(servers) > 0 {
+		base := strings.TrimSuffix(servers[0].URL, "/")
+		if !strings.HasPrefix(path, base+"/") && path != base {
+			return nil, false
+		}
+		path = strings.TrimPrefix(path, base)
+	}
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return strings.Split(path, "/"), true
+}
+
+// validateParams checks the type of the path params and of the query params op declares
+// validateParams verifica el tipo de los parámetros de ruta y de los parámetros de query que declara op
+func (d *Document) validateParams(op *Operation, pathParams map[string]string, r *http.Request) error {
+	query := r.URL.Query()
+	for _, param := range op.Parameters {
+		value, present := pathParams[param.Name], param.In == "path"
+		if param.In == "query" {
+			value, present = query.Get(param.Name), query.Has(param.Name) && query.Get(param.Name) != ""
+		}
+		if !present || paramMatches(param.Schema, value) {
+			continue
+		}
+		kind := param.Schema.Type
+		if param.Schema.Format != "" {
+			kind = param.Schema.Format
+		}
+		return fmt.Errorf("%w: %s parameter %s must be of type %s, got %q", error_message.ErrBadRequest, param.In, param.Name, kind, value)
+	}
+	return nil
+}
+
+func paramMatches(schema *Schema, value string) bool {
+	switch schema.Type {
+	case "integer":
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case "boolean":
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	case "string":
+		if schema.Format == "date" {
+			_, err := time.Parse(time.DateOnly, value)
+			return err == nil
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// validateBody reads the JSON body and checks it against the request schema, returning the raw body for the handler
+// validateBody lee el cuerpo JSON y lo verifica contra el schema de la solicitud, devolviendo el cuerpo crudo para el handler
+func (d *Document) validateBody(op *Operation, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading body: %v", error_message.ErrBadRequest, err)
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body, fmt.Errorf("%w: request body is required", error_message.ErrBadRequest)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return body, fmt.Errorf("%w: invalid JSON body: %v", error_message.ErrBadRequest, err)
+	}
+
+	errs := validation.Errors{}
+	d.check(op.RequestBody.Content["application/json"].Schema, value, "", errs)
+	if len(errs) > 0 {
+		return body, errs
+	}
+	return body, nil
+}
+
+// check walks value against schema collecting errors keyed by dotted field path, like data.order_date
+// check recorre value contra schema juntando errores indexados por la ruta del campo, como data.order_date
+func (d *Document) check(schema *Schema, value any, field string, errs validation.Errors) {
+	if schema.Ref != "" {
+		schema = d.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+	}
+	key := field
+	if key == "" {
+		key = "body"
+	}
+
+	if value == nil {
+		if !schema.Nullable && schema.Type != "" {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+		}
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+			return
+		}
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, known := schema.Properties[name], schema.AdditionalProperties != nil
+			if property == nil && !known {
+				errs[joinField(field, name)] = errFieldUnknown
+				continue
+			}
+			if property == nil {
+				property = schema.AdditionalProperties
+			}
+			d.check(property, object[name], joinField(field, name), errs)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+			return
+		}
+		for i, item := range items {
+			d.check(schema.Items, item, joinField(field, strconv.Itoa(i)), errs)
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+			return
+		}
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, text); err != nil {
+				errs[key] = errDateInvalid
+			}
+		}
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+			return
+		}
+		if _, err := number.Int64(); err != nil && schema.Type == "integer" {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs[key] = errTypeInvalid.SetParams(map[string]any{"type": schema.Type})
+		}
+	}
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
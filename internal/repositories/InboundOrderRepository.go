@@ -10,18 +10,12 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
-var inboundOrderRepositoryInstance InboundOrderRepositoryI
-
-// GetNewInboundOrderMySQLRepository - Creates and returns a new instance of MySqlInboundOrderRepository using singleton pattern
-// GetNewInboundOrderMySQLRepository - Crea y retorna una nueva instancia de MySqlInboundOrderRepository usando patrón singleton
+// GetNewInboundOrderMySQLRepository - Creates and returns a new instance of MySqlInboundOrderRepository
+// GetNewInboundOrderMySQLRepository - Crea y retorna una nueva instancia de MySqlInboundOrderRepository
 func GetNewInboundOrderMySQLRepository(db *sql.DB) InboundOrderRepositoryI {
-	if inboundOrderRepositoryInstance != nil {
-		return inboundOrderRepositoryInstance
-	}
-	inboundOrderRepositoryInstance = &MySqlInboundOrderRepository{
+	return &MySqlInboundOrderRepository{
 		db: db,
 	}
-	return inboundOrderRepositoryInstance
 }
 
 // InboundOrderRepositoryI - Interface defining the contract for inbound order repository operations
@@ -67,7 +61,7 @@ func (r *MySqlInboundOrderRepository) GetAllInboundOrdersReports(ctx context.Con
 
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return []models.InboundOrderReport{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return []models.InboundOrderReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -77,7 +71,7 @@ func (r *MySqlInboundOrderRepository) GetAllInboundOrdersReports(ctx context.Con
 		var report models.InboundOrderReport
 		err := rows.Scan(&report.Id, &report.IdCardNumber, &report.FirstName, &report.LastName, &report.InboundOrderCount)
 		if err != nil {
-			return []models.InboundOrderReport{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return []models.InboundOrderReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 		reports = append(reports, report)
 	}
@@ -108,7 +102,7 @@ func (r *MySqlInboundOrderRepository) GetInboundOrdersReportByEmployeeId(ctx con
 		if errors.Is(err, sql.ErrNoRows) {
 			return models.InboundOrderReport{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "employee with Id", employeeId, "doesn't exist.")
 		}
-		return models.InboundOrderReport{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.InboundOrderReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	return report, nil
@@ -131,13 +125,13 @@ func (r *MySqlInboundOrderRepository) Create(ctx context.Context, inbound models
 		inbound.WarehouseId,
 	)
 	if err != nil {
-		return models.InboundOrder{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.InboundOrder{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Get the auto-generated ID from the database / Obtiene el ID autogenerado de la base de datos
 	id, err := result.LastInsertId()
 	if err != nil {
-		return models.InboundOrder{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.InboundOrder{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	inbound.Id = int(id)
@@ -152,7 +146,7 @@ func (r *MySqlInboundOrderRepository) ExistsByOrderNumber(ctx context.Context, o
 	query := "SELECT EXISTS(SELECT 1 FROM inbound_orders WHERE order_number = ?)"
 	err := r.db.QueryRowContext(ctx, query, orderNumber).Scan(&exists)
 	if err != nil {
-		return false, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return false, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	return exists, nil
 }
@@ -11,19 +11,12 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
-var buyerRepositoryInstance BuyerRepositoryI
-
-// GetNewBuyerMySQLRepository - Creates and returns a new instance of MySqlBuyerRepository using singleton pattern
-// GetNewBuyerMySQLRepository - Crea y retorna una nueva instancia de MySqlBuyerRepository usando patrón singleton
+// GetNewBuyerMySQLRepository - Creates and returns a new instance of MySqlBuyerRepository
+// GetNewBuyerMySQLRepository - Crea y retorna una nueva instancia de MySqlBuyerRepository
 func GetNewBuyerMySQLRepository(db *sql.DB) BuyerRepositoryI {
-	if buyerRepositoryInstance != nil {
-		return buyerRepositoryInstance
-	}
-
-	buyerRepositoryInstance = &MySqlBuyerRepository{
+	return &MySqlBuyerRepository{
 		db: db,
 	}
-	return buyerRepositoryInstance
 }
 
 // BuyerRepositoryI - Interface defining the contract for buyer repository operations
@@ -51,7 +44,7 @@ type BuyerRepositoryI interface {
 
 	// GetCardNumberIds - Retrieves all card number IDs from the database for validation purposes
 	// GetCardNumberIds - Obtiene todos los IDs de números de tarjeta de la base de datos para propósitos de validación
-	GetCardNumberIds() ([]string, error)
+	GetCardNumberIds(ctx context.Context) ([]string, error)
 
 	// ExistBuyerById - Checks if a buyer with the given ID exists in the database
 	// ExistBuyerById - Verifica si un comprador con el ID dado existe en la base de datos
@@ -73,7 +66,7 @@ func (r *MySqlBuyerRepository) GetAll(ctx context.Context) (map[int]models.Buyer
 	query := "select id, id_card_number, first_name, last_name from buyers"
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return buyers, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return buyers, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -84,7 +77,7 @@ func (r *MySqlBuyerRepository) GetAll(ctx context.Context) (map[int]models.Buyer
 		buyer := models.Buyer{}
 		err = rows.Scan(&buyer.Id, &buyer.CardNumberId, &buyer.FirstName, &buyer.LastName)
 		if err != nil {
-			return buyers, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return buyers, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 		tempBuyersMap[buyer.Id] = buyer
 	}
@@ -103,7 +96,7 @@ func (r *MySqlBuyerRepository) GetById(ctx context.Context, id int) (models.Buye
 	row := r.db.QueryRowContext(ctx, query, id)
 	err := row.Err()
 	if err != nil {
-		return buyer, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return buyer, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	err = row.Scan(&buyer.Id, &buyer.CardNumberId, &buyer.FirstName, &buyer.LastName)
@@ -126,13 +119,13 @@ func (r *MySqlBuyerRepository) DeleteById(ctx context.Context, id int) error {
 
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("%w. %s", error_message.ErrInternalServerError, err)
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Check if any rows were affected to confirm deletion / Verifica si alguna fila fue afectada para confirmar la eliminación
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w. %s", error_message.ErrInternalServerError, err)
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// If no rows affected, buyer doesn't exist / Si ninguna fila fue afectada, el comprador no existe
@@ -152,13 +145,13 @@ func (r *MySqlBuyerRepository) Create(ctx context.Context, buyer models.Buyer) (
 	result, err := r.db.ExecContext(ctx, query, buyer.CardNumberId, buyer.FirstName, buyer.LastName)
 
 	if err != nil {
-		return models.Buyer{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Buyer{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Get the auto-generated ID from the database / Obtiene el ID autogenerado de la base de datos
 	lastId, err := result.LastInsertId()
 	if err != nil {
-		return models.Buyer{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Buyer{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	buyer.Id = int(lastId)
@@ -191,13 +184,13 @@ func (r *MySqlBuyerRepository) Update(ctx context.Context, buyerId int, buyer mo
 
 	result, err := r.db.ExecContext(ctx, query, values...)
 	if err != nil {
-		return models.Buyer{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Buyer{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Check if any rows were affected to confirm update / Verifica si alguna fila fue afectada para confirmar la actualización
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return models.Buyer{}, fmt.Errorf("%w. %s", error_message.ErrInternalServerError, err)
+		return models.Buyer{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// If no rows affected, buyer doesn't exist / Si ninguna fila fue afectada, el comprador no existe
@@ -208,21 +201,21 @@ func (r *MySqlBuyerRepository) Update(ctx context.Context, buyerId int, buyer mo
 	// Retrieve and return the updated buyer / Obtiene y retorna el comprador actualizado
 	updatedUser, err := r.GetById(ctx, buyerId)
 	if err != nil {
-		return models.Buyer{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Buyer{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	return updatedUser, nil
 }
 
 // GetCardNumberIds - Retrieves all card number IDs from the MySQL database for validation purposes
 // GetCardNumberIds - Obtiene todos los IDs de números de tarjeta de la base de datos MySQL para propósitos de validación
-func (r *MySqlBuyerRepository) GetCardNumberIds() ([]string, error) {
+func (r *MySqlBuyerRepository) GetCardNumberIds(ctx context.Context) ([]string, error) {
 	cardNumberIds := []string{}
 
 	// SQL query to select all card number IDs / Consulta SQL para seleccionar todos los IDs de números de tarjeta
 	query := "select id_card_number from buyers"
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return []string{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return []string{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -231,7 +224,7 @@ func (r *MySqlBuyerRepository) GetCardNumberIds() ([]string, error) {
 		cardNumberId := ""
 		err = rows.Scan(&cardNumberId)
 		if err != nil {
-			return []string{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return []string{}, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 
 		cardNumberIds = append(cardNumberIds, cardNumberId)
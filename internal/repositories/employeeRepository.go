@@ -11,19 +11,12 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
-var employeeRepositoryInstance EmployeeRepositoryI
-
-// GetNewEmployeeMySQLRepository - Creates and returns a new instance of MySqlEmployeeRepository using singleton pattern
-// GetNewEmployeeMySQLRepository - Crea y retorna una nueva instancia de MySqlEmployeeRepository usando patrón singleton
+// GetNewEmployeeMySQLRepository - Creates and returns a new instance of MySqlEmployeeRepository
+// GetNewEmployeeMySQLRepository - Crea y retorna una nueva instancia de MySqlEmployeeRepository
 func GetNewEmployeeMySQLRepository(db *sql.DB) EmployeeRepositoryI {
-	if employeeRepositoryInstance != nil {
-		return employeeRepositoryInstance
-	}
-
-	employeeRepositoryInstance = &MySqlEmployeeRepository{
+	return &MySqlEmployeeRepository{
 		db: db,
 	}
-	return employeeRepositoryInstance
 }
 
 // EmployeeRepositoryI - Interface defining the contract for employee repository operations
@@ -51,7 +44,7 @@ type EmployeeRepositoryI interface {
 
 	// GetCardNumberIds - Retrieves all card number IDs from the database for validation purposes
 	// GetCardNumberIds - Obtiene todos los IDs de números de tarjeta de la base de datos para propósitos de validación
-	GetCardNumberIds() ([]string, error)
+	GetCardNumberIds(ctx context.Context) ([]string, error)
 
 	// ExistEmployeeById - Checks if an employee with the given ID exists in the database
 	// ExistEmployeeById - Verifica si un empleado con el ID dado existe en la base de datos
@@ -73,7 +66,7 @@ func (r *MySqlEmployeeRepository) GetAll(ctx context.Context) (map[int]models.Em
 	query := "SELECT id, id_card_number, first_name, last_name, warehouse_id FROM employees"
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return employees, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return employees, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -83,7 +76,7 @@ func (r *MySqlEmployeeRepository) GetAll(ctx context.Context) (map[int]models.Em
 		employee := models.Employee{}
 		err = rows.Scan(&employee.Id, &employee.CardNumberID, &employee.FirstName, &employee.LastName, &employee.WarehouseID)
 		if err != nil {
-			return employees, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return employees, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 		employees[employee.Id] = employee
 	}
@@ -120,13 +113,13 @@ func (r *MySqlEmployeeRepository) DeleteById(ctx context.Context, id int) error
 
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("%w. %s", error_message.ErrInternalServerError, err)
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Check if any rows were affected to confirm deletion / Verifica si alguna fila fue afectada para confirmar la eliminación
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w. %s", error_message.ErrInternalServerError, err)
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// If no rows affected, employee doesn't exist / Si ninguna fila fue afectada, el empleado no existe
@@ -145,13 +138,13 @@ func (r *MySqlEmployeeRepository) Create(ctx context.Context, employee models.Em
 
 	result, err := r.db.ExecContext(ctx, query, employee.CardNumberID, employee.FirstName, employee.LastName, employee.WarehouseID)
 	if err != nil {
-		return models.Employee{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Employee{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Get the auto-generated ID from the database / Obtiene el ID autogenerado de la base de datos
 	lastId, err := result.LastInsertId()
 	if err != nil {
-		return models.Employee{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Employee{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	employee.Id = int(lastId)
@@ -188,13 +181,13 @@ func (r *MySqlEmployeeRepository) Update(ctx context.Context, employeeId int, em
 
 	result, err := r.db.ExecContext(ctx, query, values...)
 	if err != nil {
-		return models.Employee{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Employee{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Check if any rows were affected to confirm update / Verifica si alguna fila fue afectada para confirmar la actualización
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return models.Employee{}, fmt.Errorf("%w. %s", error_message.ErrInternalServerError, err)
+		return models.Employee{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// If no rows affected, employee doesn't exist / Si ninguna fila fue afectada, el empleado no existe
@@ -205,21 +198,21 @@ func (r *MySqlEmployeeRepository) Update(ctx context.Context, employeeId int, em
 	// Retrieve and return the updated employee / Obtiene y retorna el empleado actualizado
 	updatedEmployee, err := r.GetById(ctx, employeeId)
 	if err != nil {
-		return models.Employee{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.Employee{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	return updatedEmployee, nil
 }
 
 // GetCardNumberIds - Retrieves all card number IDs from the MySQL database for validation purposes
 // GetCardNumberIds - Obtiene todos los IDs de números de tarjeta de la base de datos MySQL para propósitos de validación
-func (r *MySqlEmployeeRepository) GetCardNumberIds() ([]string, error) {
+func (r *MySqlEmployeeRepository) GetCardNumberIds(ctx context.Context) ([]string, error) {
 	cardNumberIds := []string{}
 
 	// SQL query to select all card number IDs / Consulta SQL para seleccionar todos los IDs de números de tarjeta
 	query := "SELECT id_card_number FROM employees"
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return []string{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return []string{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -228,7 +221,7 @@ func (r *MySqlEmployeeRepository) GetCardNumberIds() ([]string, error) {
 		cardNumberId := ""
 		err = rows.Scan(&cardNumberId)
 		if err != nil {
-			return []string{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return []string{}, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 
 		cardNumberIds = append(cardNumberIds, cardNumberId)
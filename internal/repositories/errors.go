@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/logging"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
+)
+
+// dbError logs a failed query with the request ID of ctx, marks the span of ctx as failed and wraps it in sentinel for the handlers
+// The log record points at the repository method that called dbError
+// dbError registra una consulta fallida con el ID de solicitud de ctx, marca el span de ctx como fallido y la envuelve en sentinel para los handlers
+// El registro apunta al método del repositorio que llamó a dbError
+func dbError(ctx context.Context, sentinel, err error) error {
+	tracing.Fail(ctx, err)
+	logging.Error(ctx, 1, "repository: database error", err)
+	return fmt.Errorf("%w: %v", sentinel, err)
+}
@@ -0,0 +1,442 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
+)
+
+// Geography query strings - organized by level / Cadenas de consulta de geografía - organizadas por nivel
+var (
+	// Country queries / Consultas de países
+	queryGetAllCountries = "SELECT `id`, `country_name` FROM `countries` ORDER BY `id`"
+	queryGetCountryById  = "SELECT `id`, `country_name` FROM `countries` WHERE `id` = ?"
+	queryUpdateCountry   = "UPDATE `countries` SET `country_name` = ?, `normalized_name` = ? WHERE `id` = ?"
+	queryDeleteCountry   = "DELETE FROM `countries` WHERE `id` = ?"
+
+	// Province queries / Consultas de provincias
+	queryProvinceSelect     = "SELECT p.`id`, p.`province_name`, c.`id`, c.`country_name` FROM `provinces` p INNER JOIN `countries` c ON c.`id` = p.`id_country_fk`"
+	queryGetAllProvinces    = queryProvinceSelect + " WHERE (? = 0 OR c.`id` = ?) ORDER BY p.`id`"
+	queryGetProvinceById    = queryProvinceSelect + " WHERE p.`id` = ?"
+	queryFindProvinceByName = "SELECT `id` FROM `provinces` WHERE `id_country_fk` = ? AND `normalized_name` = ? ORDER BY `id` LIMIT 1"
+	queryUpdateProvince     = "UPDATE `provinces` SET `province_name` = ?, `normalized_name` = ?, `id_country_fk` = ? WHERE `id` = ?"
+	queryMoveProvince       = "UPDATE `provinces` SET `id_country_fk` = ? WHERE `id` = ?"
+	queryDeleteProvince     = "DELETE FROM `provinces` WHERE `id` = ?"
+
+	// Locality queries / Consultas de localidades
+	queryLocalitySelect   = "SELECT l.`id`, l.`locality_name`, p.`id`, p.`province_name`, c.`id`, c.`country_name` FROM `localities` l INNER JOIN `provinces` p ON p.`id` = l.`province_id` INNER JOIN `countries` c ON c.`id` = p.`id_country_fk`"
+	queryGetAllLocalities = queryLocalitySelect + " WHERE (? = 0 OR p.`id` = ?) ORDER BY l.`id`"
+	queryGetLocalityById  = queryLocalitySelect + " WHERE l.`id` = ?"
+	querySearchLocalities = queryLocalitySelect + " WHERE l.`normalized_name` LIKE ? OR l.`normalized_name` LIKE ?" +
+		" OR p.`normalized_name` LIKE ? OR p.`normalized_name` LIKE ? OR c.`normalized_name` LIKE ? OR c.`normalized_name` LIKE ? ORDER BY l.`id`"
+	queryFindLocalityByName = "SELECT `id` FROM `localities` WHERE `province_id` = ? AND `normalized_name` = ? ORDER BY `id` LIMIT 1"
+	queryUpdateLocality     = "UPDATE `localities` SET `locality_name` = ?, `normalized_name` = ?, `province_id` = ? WHERE `id` = ?"
+	queryMoveLocality       = "UPDATE `localities` SET `province_id` = ? WHERE `id` = ?"
+	queryDeleteLocality     = "DELETE FROM `localities` WHERE `id` = ?"
+
+	// Children lookups used while merging / Búsqueda de hijos usada al fusionar
+	queryProvincesOfCountry   = "SELECT `id`, `normalized_name` FROM `provinces` WHERE `id_country_fk` = ? ORDER BY `id`"
+	queryLocalitiesOfProvince = "SELECT `id`, `normalized_name` FROM `localities` WHERE `province_id` = ? ORDER BY `id`"
+
+	// Repoint queries, run before deleting a merged locality / Consultas de reasignación, ejecutadas antes de borrar una localidad fusionada
+	queryRepointSellers    = "UPDATE `sellers` SET `locality_id` = ? WHERE `locality_id` = ?"
+	queryRepointCarriers   = "UPDATE `carriers` SET `locality_id` = ? WHERE `locality_id` = ?"
+	queryRepointWarehouses = "UPDATE `warehouse` SET `locality_id` = ? WHERE `locality_id` = ?"
+
+	// Whole hierarchy, one row per locality (or per empty country/province) / Jerarquía completa, una fila por localidad (o por país/provincia vacíos)
+	queryGeographyTree = "SELECT c.`id`, c.`country_name`, p.`id`, p.`province_name`, l.`id`, l.`locality_name` " +
+		"FROM `countries` c LEFT JOIN `provinces` p ON p.`id_country_fk` = c.`id` LEFT JOIN `localities` l ON l.`province_id` = p.`id` " +
+		"ORDER BY c.`id`, p.`id`, l.`id`"
+)
+
+// NewGeographyRepository - Creates and returns a new instance of GeographyRepositoryImpl
+// NewGeographyRepository - Crea y retorna una nueva instancia de GeographyRepositoryImpl
+func NewGeographyRepository(db *sql.DB) GeographyRepository {
+	return &GeographyRepositoryImpl{db: db}
+}
+
+// GeographyRepository - Interface defining the contract for countries, provinces and localities
+// List methods take a parent id where 0 means no filter
+// GeographyRepository - Interfaz que define el contrato para países, provincias y localidades
+// Los métodos de listado reciben un id padre donde 0 significa sin filtro
+type GeographyRepository interface {
+	GetCountries(ctx context.Context) ([]models.Country, error)
+	GetCountryById(ctx context.Context, id int) (models.Country, error)
+	UpdateCountry(ctx context.Context, country models.Country) error
+
+	GetProvinces(ctx context.Context, countryId int) ([]models.Province, error)
+	GetProvinceById(ctx context.Context, id int) (models.Province, error)
+	UpdateProvince(ctx context.Context, province models.Province) error
+
+	GetLocalities(ctx context.Context, provinceId int) ([]models.Locality, error)
+	GetLocalityById(ctx context.Context, id int) (models.Locality, error)
+	UpdateLocality(ctx context.Context, locality models.Locality) error
+
+	// SearchLocalities - Retrieves the localities whose normalized name, or that of their province or country,
+	// has a word starting with prefix; prefix must already be normalized
+	// SearchLocalities - Obtiene las localidades cuyo nombre normalizado, o el de su provincia o país,
+	// tiene una palabra que empieza con prefix; prefix ya debe estar normalizado
+	SearchLocalities(ctx context.Context, prefix string) ([]models.Locality, error)
+
+	// GetTree - Retrieves every country with its provinces and localities
+	// GetTree - Obtiene todos los países con sus provincias y localidades
+	GetTree(ctx context.Context) ([]models.CountryNode, error)
+
+	// MergeCountries, MergeProvinces and MergeLocalities - Fold the source into the target in a single transaction
+	// Children with the same name as a child of the target are merged recursively, the rest are moved
+	// Sellers, carriers and warehouses of merged localities are repointed and the source is deleted
+	// MergeCountries, MergeProvinces y MergeLocalities - Fusionan el origen en el destino en una única transacción
+	// Los hijos con el mismo nombre que un hijo del destino se fusionan recursivamente, el resto se mueven
+	// Los vendedores, transportistas y almacenes de las localidades fusionadas se reasignan y el origen se elimina
+	MergeCountries(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)
+	MergeProvinces(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)
+	MergeLocalities(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)
+}
+
+// GeographyRepositoryImpl - SQL implementation of the GeographyRepository interface
+// GeographyRepositoryImpl - Implementación SQL de la interfaz GeographyRepository
+type GeographyRepositoryImpl struct {
+	db *sql.DB // Database connection / Conexión a la base de datos
+}
+
+// GetCountries - Retrieves all countries ordered by ID
+// GetCountries - Obtiene todos los países ordenados por ID
+func (r *GeographyRepositoryImpl) GetCountries(ctx context.Context) ([]models.Country, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetAllCountries)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	countries := []models.Country{}
+	for rows.Next() {
+		var c models.Country
+		if err := rows.Scan(&c.Id, &c.CountryName); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+		countries = append(countries, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return countries, nil
+}
+
+// GetCountryById - Retrieves a country by ID, returning ErrNotFound if it doesn't exist
+// GetCountryById - Obtiene un país por ID, retornando ErrNotFound si no existe
+func (r *GeographyRepositoryImpl) GetCountryById(ctx context.Context, id int) (models.Country, error) {
+	var c models.Country
+	if err := r.db.QueryRowContext(ctx, queryGetCountryById, id).Scan(&c.Id, &c.CountryName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Country{}, fmt.Errorf("%w: country with id %d", error_message.ErrNotFound, id)
+		}
+		return models.Country{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return c, nil
+}
+
+// UpdateCountry - Overwrites the country name
+// UpdateCountry - Sobrescribe el nombre del país
+func (r *GeographyRepositoryImpl) UpdateCountry(ctx context.Context, country models.Country) error {
+	if _, err := r.db.ExecContext(ctx, queryUpdateCountry, country.CountryName, tools.NormalizeName(country.CountryName), country.Id); err != nil {
+		return dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return nil
+}
+
+// GetProvinces - Retrieves provinces, optionally only those of a country
+// GetProvinces - Obtiene las provincias, opcionalmente solo las de un país
+func (r *GeographyRepositoryImpl) GetProvinces(ctx context.Context, countryId int) ([]models.Province, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetAllProvinces, countryId, countryId)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	provinces := []models.Province{}
+	for rows.Next() {
+		var p models.Province
+		if err := rows.Scan(&p.Id, &p.ProvinceName, &p.CountryId, &p.CountryName); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+		provinces = append(provinces, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return provinces, nil
+}
+
+// GetProvinceById - Retrieves a province with its country, returning ErrNotFound if it doesn't exist
+// GetProvinceById - Obtiene una provincia con su país, retornando ErrNotFound si no existe
+func (r *GeographyRepositoryImpl) GetProvinceById(ctx context.Context, id int) (models.Province, error) {
+	var p models.Province
+	if err := r.db.QueryRowContext(ctx, queryGetProvinceById, id).Scan(&p.Id, &p.ProvinceName, &p.CountryId, &p.CountryName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Province{}, fmt.Errorf("%w: province with id %d", error_message.ErrNotFound, id)
+		}
+		return models.Province{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return p, nil
+}
+
+// UpdateProvince - Overwrites the province name and country
+// UpdateProvince - Sobrescribe el nombre y el país de la provincia
+func (r *GeographyRepositoryImpl) UpdateProvince(ctx context.Context, province models.Province) error {
+	if _, err := r.db.ExecContext(ctx, queryUpdateProvince, province.ProvinceName, tools.NormalizeName(province.ProvinceName), province.CountryId, province.Id); err != nil {
+		return dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return nil
+}
+
+// GetLocalities - Retrieves localities, optionally only those of a province
+// GetLocalities - Obtiene las localidades, opcionalmente solo las de una provincia
+func (r *GeographyRepositoryImpl) GetLocalities(ctx context.Context, provinceId int) ([]models.Locality, error) {
+	return r.queryLocalities(ctx, queryGetAllLocalities, provinceId, provinceId)
+}
+
+// SearchLocalities - Filters in SQL on the normalized names, matching the start of the name or of any of its words
+// SearchLocalities - Filtra en SQL por los nombres normalizados, buscando al inicio del nombre o de cualquiera de sus palabras
+func (r *GeographyRepositoryImpl) SearchLocalities(ctx context.Context, prefix string) ([]models.Locality, error) {
+	escaped := likeEscaper.Replace(prefix)
+	start, word := escaped+"%", "% "+escaped+"%"
+	return r.queryLocalities(ctx, querySearchLocalities, start, word, start, word, start, word)
+}
+
+// likeEscaper escapes the LIKE wildcards of a user supplied value / likeEscaper escapa los comodines de LIKE de un valor del usuario
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// queryLocalities runs a locality query and scans its rows / queryLocalities ejecuta una consulta de localidades y escanea sus filas
+func (r *GeographyRepositoryImpl) queryLocalities(ctx context.Context, query string, args ...any) ([]models.Locality, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	localities := []models.Locality{}
+	for rows.Next() {
+		var l models.Locality
+		if err := rows.Scan(&l.Id, &l.LocalityName, &l.ProvinceId, &l.ProvinceName, &l.CountryId, &l.CountryName); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+		localities = append(localities, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return localities, nil
+}
+
+// GetLocalityById - Retrieves a locality with its province and country, returning ErrNotFound if it doesn't exist
+// GetLocalityById - Obtiene una localidad con su provincia y país, retornando ErrNotFound si no existe
+func (r *GeographyRepositoryImpl) GetLocalityById(ctx context.Context, id int) (models.Locality, error) {
+	var l models.Locality
+	err := r.db.QueryRowContext(ctx, queryGetLocalityById, id).
+		Scan(&l.Id, &l.LocalityName, &l.ProvinceId, &l.ProvinceName, &l.CountryId, &l.CountryName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Locality{}, fmt.Errorf("%w: locality with id %d", error_message.ErrNotFound, id)
+		}
+		return models.Locality{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return l, nil
+}
+
+// UpdateLocality - Overwrites the locality name and province
+// UpdateLocality - Sobrescribe el nombre y la provincia de la localidad
+func (r *GeographyRepositoryImpl) UpdateLocality(ctx context.Context, locality models.Locality) error {
+	if _, err := r.db.ExecContext(ctx, queryUpdateLocality, locality.LocalityName, tools.NormalizeName(locality.LocalityName), locality.ProvinceId, locality.Id); err != nil {
+		return dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return nil
+}
+
+// GetTree - Builds the hierarchy from a single ordered query
+// GetTree - Construye la jerarquía a partir de una única consulta ordenada
+func (r *GeographyRepositoryImpl) GetTree(ctx context.Context) ([]models.CountryNode, error) {
+	rows, err := r.db.QueryContext(ctx, queryGeographyTree)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	tree := []models.CountryNode{}
+	for rows.Next() {
+		var (
+			countryId                  int
+			countryName                string
+			provinceId, localityId     sql.NullInt64
+			provinceName, localityName sql.NullString
+		)
+		if err := rows.Scan(&countryId, &countryName, &provinceId, &provinceName, &localityId, &localityName); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+
+		// Rows arrive ordered, so a new id always opens a new node / Las filas llegan ordenadas, así que un id nuevo siempre abre un nodo nuevo
+		if len(tree) == 0 || tree[len(tree)-1].Id != countryId {
+			tree = append(tree, models.CountryNode{Id: countryId, CountryName: countryName, Provinces: []models.ProvinceNode{}})
+		}
+		if !provinceId.Valid {
+			continue
+		}
+		country := &tree[len(tree)-1]
+		if len(country.Provinces) == 0 || country.Provinces[len(country.Provinces)-1].Id != int(provinceId.Int64) {
+			country.Provinces = append(country.Provinces, models.ProvinceNode{Id: int(provinceId.Int64), ProvinceName: provinceName.String, Localities: []models.LocalityNode{}})
+		}
+		if !localityId.Valid {
+			continue
+		}
+		province := &country.Provinces[len(country.Provinces)-1]
+		province.Localities = append(province.Localities, models.LocalityNode{Id: int(localityId.Int64), LocalityName: localityName.String})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return tree, nil
+}
+
+// MergeCountries - Folds the source country into the target
+// MergeCountries - Fusiona el país origen en el destino
+func (r *GeographyRepositoryImpl) MergeCountries(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	return r.merge(ctx, sourceId, targetId, mergeCountries)
+}
+
+// MergeProvinces - Folds the source province into the target
+// MergeProvinces - Fusiona la provincia origen en la destino
+func (r *GeographyRepositoryImpl) MergeProvinces(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	return r.merge(ctx, sourceId, targetId, mergeProvinces)
+}
+
+// MergeLocalities - Folds the source locality into the target
+// MergeLocalities - Fusiona la localidad origen en la destino
+func (r *GeographyRepositoryImpl) MergeLocalities(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	return r.merge(ctx, sourceId, targetId, mergeLocalities)
+}
+
+// mergeFunc - One level of the merge, running inside the caller's transaction
+// mergeFunc - Un nivel de la fusión, ejecutado dentro de la transacción de quien llama
+type mergeFunc func(ctx context.Context, tx *sql.Tx, sourceId, targetId int, result *models.GeographyMergeResult) error
+
+// merge - Runs a merge inside a transaction, rolling back on any error
+// merge - Ejecuta una fusión dentro de una transacción, revirtiendo ante cualquier error
+func (r *GeographyRepositoryImpl) merge(ctx context.Context, sourceId, targetId int, fn mergeFunc) (models.GeographyMergeResult, error) {
+	result := models.GeographyMergeResult{SourceId: sourceId, TargetId: targetId}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.GeographyMergeResult{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(ctx, tx, sourceId, targetId, &result); err != nil {
+		return models.GeographyMergeResult{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return models.GeographyMergeResult{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return result, nil
+}
+
+func mergeCountries(ctx context.Context, tx *sql.Tx, sourceId, targetId int, result *models.GeographyMergeResult) error {
+	children, err := namedChildren(ctx, tx, queryProvincesOfCountry, sourceId)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := mergeOrMove(ctx, tx, child, targetId, queryFindProvinceByName, queryMoveProvince, mergeProvinces, result); err != nil {
+			return err
+		}
+		result.Provinces++
+	}
+	_, err = tx.ExecContext(ctx, queryDeleteCountry, sourceId)
+	return err
+}
+
+func mergeProvinces(ctx context.Context, tx *sql.Tx, sourceId, targetId int, result *models.GeographyMergeResult) error {
+	children, err := namedChildren(ctx, tx, queryLocalitiesOfProvince, sourceId)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := mergeOrMove(ctx, tx, child, targetId, queryFindLocalityByName, queryMoveLocality, mergeLocalities, result); err != nil {
+			return err
+		}
+		result.Localities++
+	}
+	_, err = tx.ExecContext(ctx, queryDeleteProvince, sourceId)
+	return err
+}
+
+func mergeLocalities(ctx context.Context, tx *sql.Tx, sourceId, targetId int, result *models.GeographyMergeResult) error {
+	repoints := []struct {
+		query   string
+		counter *int
+	}{
+		{queryRepointSellers, &result.Sellers},
+		{queryRepointCarriers, &result.Carriers},
+		{queryRepointWarehouses, &result.Warehouses},
+	}
+	for _, repoint := range repoints {
+		res, err := tx.ExecContext(ctx, repoint.query, targetId, sourceId)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		*repoint.counter += int(affected)
+	}
+	_, err := tx.ExecContext(ctx, queryDeleteLocality, sourceId)
+	return err
+}
+
+// namedChild - Id and normalized name of a child row considered during a merge
+// namedChild - Id y nombre normalizado de una fila hija considerada durante una fusión
+type namedChild struct {
+	id   int
+	name string
+}
+
+// namedChildren - Loads the children up front so the rows are closed before writing in the same transaction
+// namedChildren - Carga los hijos de antemano para cerrar las filas antes de escribir en la misma transacción
+func namedChildren(ctx context.Context, tx *sql.Tx, query string, parentId int) ([]namedChild, error) {
+	rows, err := tx.QueryContext(ctx, query, parentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	children := []namedChild{}
+	for rows.Next() {
+		var child namedChild
+		if err := rows.Scan(&child.id, &child.name); err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	return children, rows.Err()
+}
+
+// mergeOrMove - Merges the child into the target's child with the same name, or moves it under the target
+// mergeOrMove - Fusiona el hijo en el hijo del destino con el mismo nombre, o lo mueve bajo el destino
+func mergeOrMove(ctx context.Context, tx *sql.Tx, child namedChild, targetId int, findQuery, moveQuery string, merge mergeFunc, result *models.GeographyMergeResult) error {
+	var twinId int
+	err := tx.QueryRowContext(ctx, findQuery, targetId, child.name).Scan(&twinId)
+	switch {
+	case err == nil:
+		return merge(ctx, tx, child.id, twinId, result)
+	case errors.Is(err, sql.ErrNoRows):
+		_, err = tx.ExecContext(ctx, moveQuery, targetId, child.id)
+		return err
+	default:
+		return err
+	}
+}
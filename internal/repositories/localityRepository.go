@@ -3,24 +3,18 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
-	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
 )
 
-var localityRepositoryInstance LocalityRepository
-
-// NewSQLLocalityRepository - Creates and returns a new instance of SQLLocalityRepository using singleton pattern
-// NewSQLLocalityRepository - Crea y retorna una nueva instancia de SQLLocalityRepository usando patrón singleton
+// NewSQLLocalityRepository - Creates and returns a new instance of SQLLocalityRepository
+// NewSQLLocalityRepository - Crea y retorna una nueva instancia de SQLLocalityRepository
 func NewSQLLocalityRepository(db *sql.DB) LocalityRepository {
-	if localityRepositoryInstance != nil {
-		return localityRepositoryInstance
-	}
-
-	localityRepositoryInstance = &SQLLocalityRepository{db: db}
-	return localityRepositoryInstance
+	return &SQLLocalityRepository{db: db}
 }
 
 // LocalityRepository - Interface defining the contract for locality repository operations
@@ -30,9 +24,11 @@ type LocalityRepository interface {
 	// Save - Crea una nueva localidad en la base de datos, manejando las relaciones de país y provincia
 	Save(ctx context.Context, locality models.Locality) (models.Locality, error)
 
-	// GetSellerReports - Retrieves seller reports for all localities or a specific locality by ID
-	// GetSellerReports - Obtiene reportes de vendedores para todas las localidades o una localidad específica por ID
-	GetSellerReports(ctx context.Context, localityID int) ([]responses.LocalitySellerReport, error)
+	// GetLocalityCounts - Retrieves every country, province and locality with the sellers, carriers and warehouses of each locality
+	// An id other than 0 restricts the rows to the country, province or locality with that id, depending on level
+	// GetLocalityCounts - Obtiene todos los países, provincias y localidades con los vendedores, transportistas y almacenes de cada localidad
+	// Un id distinto de 0 restringe las filas al país, provincia o localidad con ese id, según level
+	GetLocalityCounts(ctx context.Context, level string, id int) ([]models.LocalityCounts, error)
 
 	// ExistById - Checks if a locality with the given ID exists in the database
 	// ExistById - Verifica si una localidad con el ID dado existe en la base de datos
@@ -48,45 +44,51 @@ type SQLLocalityRepository struct {
 // Save - Creates a new locality in the database with automatic country and province management
 // Save - Crea una nueva localidad en la base de datos con manejo automático de país y provincia
 func (r *SQLLocalityRepository) Save(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	// Names are matched by their normalized form so "Cordoba" reuses "Córdoba"
+	// Los nombres se comparan por su forma normalizada así "Cordoba" reutiliza "Córdoba"
+	countryKey := tools.NormalizeName(locality.CountryName)
+	provinceKey := tools.NormalizeName(locality.ProvinceName)
+	localityKey := tools.NormalizeName(locality.LocalityName)
+
 	// 1. Find or insert the country / 1. Buscar o insertar el país
 	var countryID int
-	err := r.db.QueryRowContext(ctx, "SELECT id FROM countries WHERE country_name = ?", locality.CountryName).Scan(&countryID)
+	err := r.db.QueryRowContext(ctx, "SELECT id, country_name FROM countries WHERE normalized_name = ? ORDER BY id LIMIT 1", countryKey).Scan(&countryID, &locality.CountryName)
 	if err == sql.ErrNoRows {
 		// Create new country if it doesn't exist / Crear nuevo país si no existe
-		res, err := r.db.ExecContext(ctx, "INSERT INTO countries (country_name) VALUES (?)", locality.CountryName)
+		res, err := r.db.ExecContext(ctx, "INSERT INTO countries (country_name, normalized_name) VALUES (?, ?)", locality.CountryName, countryKey)
 		if err != nil {
-			return models.Locality{}, error_message.ErrQuery
+			return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 		}
 		lastID, _ := res.LastInsertId()
 		countryID = int(lastID)
 	} else if err != nil {
-		return models.Locality{}, error_message.ErrQuery
+		return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 	}
 
 	// 2. Find or insert the province / 2. Buscar o insertar la provincia
 	var provinceID int
-	err = r.db.QueryRowContext(ctx, "SELECT id FROM provinces WHERE province_name = ? AND id_country_fk = ?", locality.ProvinceName, countryID).Scan(&provinceID)
+	err = r.db.QueryRowContext(ctx, "SELECT id, province_name FROM provinces WHERE normalized_name = ? AND id_country_fk = ? ORDER BY id LIMIT 1", provinceKey, countryID).Scan(&provinceID, &locality.ProvinceName)
 	if err == sql.ErrNoRows {
 		// Create new province if it doesn't exist / Crear nueva provincia si no existe
-		res, err := r.db.ExecContext(ctx, "INSERT INTO provinces (province_name, id_country_fk) VALUES (?, ?)", locality.ProvinceName, countryID)
+		res, err := r.db.ExecContext(ctx, "INSERT INTO provinces (province_name, normalized_name, id_country_fk) VALUES (?, ?, ?)", locality.ProvinceName, provinceKey, countryID)
 		if err != nil {
-			return models.Locality{}, error_message.ErrQuery
+			return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 		}
 		lastID, _ := res.LastInsertId()
 		provinceID = int(lastID)
 	} else if err != nil {
-		return models.Locality{}, error_message.ErrQuery
+		return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 	}
 
 	// 3. Check if locality already exists with same name and province / 3. Verificar si ya existe una localidad con ese nombre y esa provincia
 	var exists bool
 	err = r.db.QueryRowContext(ctx, `
 		SELECT EXISTS(
-			SELECT 1 FROM localities WHERE locality_name = ? AND province_id = ?
+			SELECT 1 FROM localities WHERE normalized_name = ? AND province_id = ?
 		)
-	`, locality.LocalityName, provinceID).Scan(&exists)
+	`, localityKey, provinceID).Scan(&exists)
 	if err != nil {
-		return models.Locality{}, error_message.ErrQuery
+		return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 	}
 	if exists {
 		return models.Locality{}, error_message.ErrAlreadyExists
@@ -94,84 +96,86 @@ func (r *SQLLocalityRepository) Save(ctx context.Context, locality models.Locali
 
 	// 4. Insert new locality with auto-generated ID / 4. Insertar nueva localidad (el ID será auto-generado)
 	res, err := r.db.ExecContext(ctx,
-		"INSERT INTO localities (locality_name, province_id) VALUES (?, ?)",
-		locality.LocalityName, provinceID,
+		"INSERT INTO localities (locality_name, normalized_name, province_id) VALUES (?, ?, ?)",
+		locality.LocalityName, localityKey, provinceID,
 	)
 
 	if err != nil {
-		return models.Locality{}, error_message.ErrQuery
+		return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 	}
 
 	// Get the auto-generated ID and assign it to the locality / Obtener el ID autogenerado y asignarlo a la localidad
 	lastID, err := res.LastInsertId()
 	if err != nil {
-		return models.Locality{}, error_message.ErrQuery
+		return models.Locality{}, dbError(ctx, error_message.ErrQuery, err)
 	}
 	locality.Id = int(lastID)
+	locality.ProvinceId = provinceID
+	locality.CountryId = countryID
 
 	return locality, nil
 }
 
-// GetSellerReports - Retrieves seller reports showing locality information and seller counts
-// GetSellerReports - Obtiene reportes de vendedores mostrando información de localidad y conteos de vendedores
-func (r *SQLLocalityRepository) GetSellerReports(ctx context.Context, localityID int) ([]responses.LocalitySellerReport, error) {
-	// If specific ID provided, verify locality exists / Si se especifica un ID, verificar que exista
-	if localityID != 0 {
-		var exists bool
-		err := r.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM localities WHERE id = ?)", localityID).Scan(&exists)
-		if err != nil {
-			return nil, error_message.ErrFailedCheckingExistence
-		}
-		if !exists {
-			return nil, error_message.ErrNotFound
-		}
-	}
-
-	var (
-		rows *sql.Rows
-		err  error
-	)
+// queryLocalityCounts - Hierarchy with the counts of each locality; the correlated counts only run for the rows kept
+// queryLocalityCounts - Jerarquía con los conteos de cada localidad; los conteos correlacionados solo corren para las filas conservadas
+const queryLocalityCounts = `
+	SELECT c.id, c.country_name, p.id, p.province_name, l.id, l.locality_name,
+		(SELECT COUNT(*) FROM sellers s WHERE s.locality_id = l.id),
+		(SELECT COUNT(*) FROM carriers ca WHERE ca.locality_id = l.id),
+		(SELECT COUNT(*) FROM warehouse w WHERE w.locality_id = l.id)
+	FROM countries c
+	LEFT JOIN provinces p ON p.id_country_fk = c.id
+	LEFT JOIN localities l ON l.province_id = p.id`
+
+// localityCountsFilters - Condition restricting queryLocalityCounts to one row of each level
+// localityCountsFilters - Condición que restringe queryLocalityCounts a una fila de cada nivel
+var localityCountsFilters = map[string]string{
+	models.GeographyLevelLocality: " WHERE l.id = ?",
+	models.GeographyLevelProvince: " WHERE p.id = ?",
+	models.GeographyLevelCountry:  " WHERE c.id = ?",
+}
 
-	// Execute different query based on whether specific locality ID is requested
-	// Ejecutar consulta diferente basada en si se solicita un ID de localidad específico
-	if localityID != 0 {
-		// Query for specific locality using LEFT JOIN to include localities with zero sellers
-		// Consulta para localidad específica usando LEFT JOIN para incluir localidades sin vendedores
-		rows, err = r.db.QueryContext(ctx, `
-			SELECT l.id, l.locality_name, COUNT(s.id)
-			FROM localities l
-			LEFT JOIN sellers s ON s.locality_id = l.id
-			WHERE l.id = ?
-			GROUP BY l.id, l.locality_name
-		`, localityID)
-	} else {
-		// Query for all localities using LEFT JOIN to include localities with zero sellers
-		// Consulta para todas las localidades usando LEFT JOIN para incluir localidades sin vendedores
-		rows, err = r.db.QueryContext(ctx, `
-			SELECT l.id, l.locality_name, COUNT(s.id)
-			FROM localities l
-			LEFT JOIN sellers s ON s.locality_id = l.id
-			GROUP BY l.id, l.locality_name
-		`)
+// GetLocalityCounts - Walks countries, provinces and localities with LEFT JOINs so empty levels are kept,
+// counting the sellers, carriers and warehouses of each locality
+// GetLocalityCounts - Recorre países, provincias y localidades con LEFT JOIN para conservar los niveles vacíos,
+// contando los vendedores, transportistas y almacenes de cada localidad
+func (r *SQLLocalityRepository) GetLocalityCounts(ctx context.Context, level string, id int) ([]models.LocalityCounts, error) {
+	// The filter goes into the SQL so a single row report only counts its own localities
+	// El filtro va en el SQL así el reporte de una sola fila solo cuenta sus propias localidades
+	query, args := queryLocalityCounts, []any{}
+	if id != 0 {
+		filter, ok := localityCountsFilters[level]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown level %q", error_message.ErrInvalidInput, level)
+		}
+		query, args = queryLocalityCounts+filter, []any{id}
 	}
 
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY c.id, p.id, l.id", args...)
 	if err != nil {
-		return nil, error_message.ErrQueryingReport
+		return nil, dbError(ctx, error_message.ErrQueryingReport, err)
 	}
 	defer rows.Close()
 
-	// Iterate through all rows and scan each report into the results slice
-	// Itera a través de todas las filas y escanea cada reporte en el slice de resultados
-	var reports []responses.LocalitySellerReport
+	counts := []models.LocalityCounts{}
 	for rows.Next() {
-		var r responses.LocalitySellerReport
-		if err := rows.Scan(&r.LocalityID, &r.LocalityName, &r.SellerCount); err != nil {
-			return nil, error_message.ErrFailedToScan
+		var (
+			row                        models.LocalityCounts
+			provinceId, localityId     sql.NullInt64
+			provinceName, localityName sql.NullString
+		)
+		if err := rows.Scan(&row.CountryId, &row.CountryName, &provinceId, &provinceName, &localityId, &localityName,
+			&row.Sellers, &row.Carriers, &row.Warehouses); err != nil {
+			return nil, dbError(ctx, error_message.ErrFailedToScan, err)
 		}
-		reports = append(reports, r)
+		row.ProvinceId, row.ProvinceName = int(provinceId.Int64), provinceName.String
+		row.LocalityId, row.LocalityName = int(localityId.Int64), localityName.String
+		counts = append(counts, row)
 	}
-
-	return reports, nil
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrQueryingReport, err)
+	}
+	return counts, nil
 }
 
 // ExistById - Checks if a locality with the given ID exists in the database
@@ -182,7 +186,7 @@ func (r *SQLLocalityRepository) ExistById(ctx context.Context, localityID int) (
 	var exists bool
 	err := row.Scan(&exists)
 	if err != nil {
-		return false, error_message.ErrQuery
+		return false, dbError(ctx, error_message.ErrQuery, err)
 	}
 	return exists, nil
 }
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// BuyerRepository - In-memory implementation of repositories.BuyerRepositoryI
+// BuyerRepository - Implementación en memoria de repositories.BuyerRepositoryI
+type BuyerRepository struct {
+	store *Store
+}
+
+// NewBuyerRepository - Creates a buyer repository backed by the given store
+// NewBuyerRepository - Crea un repositorio de compradores respaldado por el almacenamiento dado
+func NewBuyerRepository(store *Store) repositories.BuyerRepositoryI {
+	return &BuyerRepository{store: store}
+}
+
+func (r *BuyerRepository) GetAll(ctx context.Context) (map[int]models.Buyer, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	buyers := make(map[int]models.Buyer, len(r.store.buyers))
+	for id, buyer := range r.store.buyers {
+		buyers[id] = buyer
+	}
+	return buyers, nil
+}
+
+func (r *BuyerRepository) GetById(ctx context.Context, id int) (models.Buyer, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	buyer, ok := r.store.buyers[id]
+	if !ok {
+		return models.Buyer{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Buyer with Id", id, "doesn't exists.")
+	}
+	return buyer, nil
+}
+
+func (r *BuyerRepository) DeleteById(ctx context.Context, id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.buyers[id]; !ok {
+		return fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Buyer with Id", id, "doesn't exists.")
+	}
+	r.store.deleteBuyer(id)
+	return nil
+}
+
+func (r *BuyerRepository) Create(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	buyer.Id = r.store.nextId("buyers")
+	r.store.buyers[buyer.Id] = buyer
+	return buyer, nil
+}
+
+func (r *BuyerRepository) Update(ctx context.Context, buyerId int, buyer models.Buyer) (models.Buyer, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, ok := r.store.buyers[buyerId]
+	if !ok {
+		return models.Buyer{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Buyer with Id", buyerId, "doesn't exists.")
+	}
+
+	// Only non empty fields are updated, like the MySQL repository / Solo se actualizan los campos no vacíos, como en el repositorio MySQL
+	if buyer.FirstName != "" {
+		existing.FirstName = buyer.FirstName
+	}
+	if buyer.LastName != "" {
+		existing.LastName = buyer.LastName
+	}
+	if buyer.CardNumberId != "" {
+		existing.CardNumberId = buyer.CardNumberId
+	}
+
+	r.store.buyers[buyerId] = existing
+	return existing, nil
+}
+
+func (r *BuyerRepository) GetCardNumberIds(ctx context.Context) ([]string, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	cardNumberIds := []string{}
+	for _, id := range sortedIds(r.store.buyers) {
+		cardNumberIds = append(cardNumberIds, r.store.buyers[id].CardNumberId)
+	}
+	return cardNumberIds, nil
+}
+
+func (r *BuyerRepository) ExistBuyerById(ctx context.Context, buyerId int) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.buyers[buyerId]
+	return ok, nil
+}
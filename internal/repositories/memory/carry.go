@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// CarryRepository - In-memory implementation of repositories.CarryRepository
+// CarryRepository - Implementación en memoria de repositories.CarryRepository
+type CarryRepository struct {
+	store *Store
+}
+
+// NewCarryRepository - Creates a carry repository backed by the given store
+// NewCarryRepository - Crea un repositorio de transportistas respaldado por el almacenamiento dado
+func NewCarryRepository(store *Store) repositories.CarryRepository {
+	return &CarryRepository{store: store}
+}
+
+func (r *CarryRepository) Create(ctx context.Context, carry models.Carry) (models.Carry, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.localities[carry.LocalityId]; !ok {
+		return models.Carry{}, errForeignKey("locality", carry.LocalityId)
+	}
+
+	carry.Id = r.store.nextId("carriers")
+	r.store.carriers[carry.Id] = carry
+	return carry, nil
+}
+
+func (r *CarryRepository) ExistsByCid(ctx context.Context, cid string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, carry := range r.store.carriers {
+		if carry.Cid == cid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
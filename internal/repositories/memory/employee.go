@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// EmployeeRepository - In-memory implementation of repositories.EmployeeRepositoryI
+// EmployeeRepository - Implementación en memoria de repositories.EmployeeRepositoryI
+type EmployeeRepository struct {
+	store *Store
+}
+
+// NewEmployeeRepository - Creates an employee repository backed by the given store
+// NewEmployeeRepository - Crea un repositorio de empleados respaldado por el almacenamiento dado
+func NewEmployeeRepository(store *Store) repositories.EmployeeRepositoryI {
+	return &EmployeeRepository{store: store}
+}
+
+func (r *EmployeeRepository) GetAll(ctx context.Context) (map[int]models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	employees := make(map[int]models.Employee, len(r.store.employees))
+	for id, employee := range r.store.employees {
+		employees[id] = employee
+	}
+	return employees, nil
+}
+
+func (r *EmployeeRepository) GetById(ctx context.Context, id int) (models.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	employee, ok := r.store.employees[id]
+	if !ok {
+		return models.Employee{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "employee with Id", id, "not exists.")
+	}
+	return employee, nil
+}
+
+func (r *EmployeeRepository) DeleteById(ctx context.Context, id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.employees[id]; !ok {
+		return fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Employee with Id", id, "doesn't exist.")
+	}
+	r.store.deleteEmployee(id)
+	return nil
+}
+
+func (r *EmployeeRepository) Create(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.warehouses[employee.WarehouseID]; !ok {
+		return models.Employee{}, errForeignKey("warehouse", employee.WarehouseID)
+	}
+
+	employee.Id = r.store.nextId("employees")
+	r.store.employees[employee.Id] = employee
+	return employee, nil
+}
+
+func (r *EmployeeRepository) Update(ctx context.Context, employeeId int, employee models.Employee) (models.Employee, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, ok := r.store.employees[employeeId]
+	if !ok {
+		return models.Employee{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "employee with Id", employeeId, "not exists.")
+	}
+
+	// Only non empty fields are updated, like the MySQL repository / Solo se actualizan los campos no vacíos, como en el repositorio MySQL
+	if employee.FirstName != "" {
+		existing.FirstName = employee.FirstName
+	}
+	if employee.LastName != "" {
+		existing.LastName = employee.LastName
+	}
+	if employee.CardNumberID != "" {
+		existing.CardNumberID = employee.CardNumberID
+	}
+	if employee.WarehouseID != 0 {
+		if _, ok := r.store.warehouses[employee.WarehouseID]; !ok {
+			return models.Employee{}, errForeignKey("warehouse", employee.WarehouseID)
+		}
+		existing.WarehouseID = employee.WarehouseID
+	}
+
+	r.store.employees[employeeId] = existing
+	return existing, nil
+}
+
+func (r *EmployeeRepository) GetCardNumberIds(ctx context.Context) ([]string, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	cardNumberIds := []string{}
+	for _, id := range sortedIds(r.store.employees) {
+		cardNumberIds = append(cardNumberIds, r.store.employees[id].CardNumberID)
+	}
+	return cardNumberIds, nil
+}
+
+func (r *EmployeeRepository) ExistEmployeeById(ctx context.Context, employeeId int) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.employees[employeeId]
+	return ok, nil
+}
@@ -0,0 +1,297 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
+)
+
+// GeographyRepository - In-memory implementation of repositories.GeographyRepository
+// GeographyRepository - Implementación en memoria de repositories.GeographyRepository
+type GeographyRepository struct {
+	store *Store
+}
+
+// NewGeographyRepository - Creates a geography repository backed by the given store
+// NewGeographyRepository - Crea un repositorio de geografía respaldado por el almacenamiento dado
+func NewGeographyRepository(store *Store) repositories.GeographyRepository {
+	return &GeographyRepository{store: store}
+}
+
+func (r *GeographyRepository) GetCountries(ctx context.Context) ([]models.Country, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	countries := []models.Country{}
+	for _, id := range sortedIds(r.store.countries) {
+		countries = append(countries, models.Country{Id: id, CountryName: r.store.countries[id].Name})
+	}
+	return countries, nil
+}
+
+func (r *GeographyRepository) GetCountryById(ctx context.Context, id int) (models.Country, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	c, ok := r.store.countries[id]
+	if !ok {
+		return models.Country{}, fmt.Errorf("%w: country with id %d", error_message.ErrNotFound, id)
+	}
+	return models.Country{Id: c.Id, CountryName: c.Name}, nil
+}
+
+func (r *GeographyRepository) UpdateCountry(ctx context.Context, c models.Country) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.countries[c.Id]; ok {
+		r.store.countries[c.Id] = country{Id: c.Id, Name: c.CountryName}
+	}
+	return nil
+}
+
+func (r *GeographyRepository) GetProvinces(ctx context.Context, countryId int) ([]models.Province, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	provinces := []models.Province{}
+	for _, id := range sortedIds(r.store.provinces) {
+		if countryId == 0 || r.store.provinces[id].CountryId == countryId {
+			provinces = append(provinces, r.store.provinceModel(id))
+		}
+	}
+	return provinces, nil
+}
+
+func (r *GeographyRepository) GetProvinceById(ctx context.Context, id int) (models.Province, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if _, ok := r.store.provinces[id]; !ok {
+		return models.Province{}, fmt.Errorf("%w: province with id %d", error_message.ErrNotFound, id)
+	}
+	return r.store.provinceModel(id), nil
+}
+
+func (r *GeographyRepository) UpdateProvince(ctx context.Context, p models.Province) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.countries[p.CountryId]; !ok {
+		return errForeignKey("country", p.CountryId)
+	}
+	if _, ok := r.store.provinces[p.Id]; ok {
+		r.store.provinces[p.Id] = province{Id: p.Id, Name: p.ProvinceName, CountryId: p.CountryId}
+	}
+	return nil
+}
+
+func (r *GeographyRepository) GetLocalities(ctx context.Context, provinceId int) ([]models.Locality, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	localities := []models.Locality{}
+	for _, id := range sortedIds(r.store.localities) {
+		if provinceId == 0 || r.store.localities[id].ProvinceId == provinceId {
+			localities = append(localities, r.store.localityModel(id))
+		}
+	}
+	return localities, nil
+}
+
+func (r *GeographyRepository) SearchLocalities(ctx context.Context, prefix string) ([]models.Locality, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	localities := []models.Locality{}
+	for _, id := range sortedIds(r.store.localities) {
+		locality := r.store.localityModel(id)
+		for _, name := range []string{locality.LocalityName, locality.ProvinceName, locality.CountryName} {
+			normalized := tools.NormalizeName(name)
+			if strings.HasPrefix(normalized, prefix) || strings.Contains(normalized, " "+prefix) {
+				localities = append(localities, locality)
+				break
+			}
+		}
+	}
+	return localities, nil
+}
+
+func (r *GeographyRepository) GetLocalityById(ctx context.Context, id int) (models.Locality, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if _, ok := r.store.localities[id]; !ok {
+		return models.Locality{}, fmt.Errorf("%w: locality with id %d", error_message.ErrNotFound, id)
+	}
+	return r.store.localityModel(id), nil
+}
+
+func (r *GeographyRepository) UpdateLocality(ctx context.Context, l models.Locality) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.provinces[l.ProvinceId]; !ok {
+		return errForeignKey("province", l.ProvinceId)
+	}
+	if _, ok := r.store.localities[l.Id]; ok {
+		r.store.localities[l.Id] = locality{Id: l.Id, Name: l.LocalityName, ProvinceId: l.ProvinceId}
+	}
+	return nil
+}
+
+func (r *GeographyRepository) GetTree(ctx context.Context) ([]models.CountryNode, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tree := []models.CountryNode{}
+	for _, countryId := range sortedIds(r.store.countries) {
+		countryNode := models.CountryNode{Id: countryId, CountryName: r.store.countries[countryId].Name, Provinces: []models.ProvinceNode{}}
+		for _, provinceId := range sortedIds(r.store.provinces) {
+			p := r.store.provinces[provinceId]
+			if p.CountryId != countryId {
+				continue
+			}
+			provinceNode := models.ProvinceNode{Id: p.Id, ProvinceName: p.Name, Localities: []models.LocalityNode{}}
+			for _, localityId := range sortedIds(r.store.localities) {
+				if l := r.store.localities[localityId]; l.ProvinceId == provinceId {
+					provinceNode.Localities = append(provinceNode.Localities, models.LocalityNode{Id: l.Id, LocalityName: l.Name})
+				}
+			}
+			countryNode.Provinces = append(countryNode.Provinces, provinceNode)
+		}
+		tree = append(tree, countryNode)
+	}
+	return tree, nil
+}
+
+func (r *GeographyRepository) MergeCountries(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	result := models.GeographyMergeResult{SourceId: sourceId, TargetId: targetId}
+	r.store.mergeCountries(sourceId, targetId, &result)
+	return result, nil
+}
+
+func (r *GeographyRepository) MergeProvinces(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	result := models.GeographyMergeResult{SourceId: sourceId, TargetId: targetId}
+	r.store.mergeProvinces(sourceId, targetId, &result)
+	return result, nil
+}
+
+func (r *GeographyRepository) MergeLocalities(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	result := models.GeographyMergeResult{SourceId: sourceId, TargetId: targetId}
+	r.store.mergeLocalities(sourceId, targetId, &result)
+	return result, nil
+}
+
+// Read and merge helpers - caller must hold the lock / Helpers de lectura y fusión - quien llama debe tener el lock
+
+func (s *Store) provinceModel(id int) models.Province {
+	p := s.provinces[id]
+	return models.Province{Id: p.Id, ProvinceName: p.Name, CountryId: p.CountryId, CountryName: s.countries[p.CountryId].Name}
+}
+
+func (s *Store) localityModel(id int) models.Locality {
+	l := s.localities[id]
+	p := s.provinces[l.ProvinceId]
+	return models.Locality{
+		Id:           l.Id,
+		LocalityName: l.Name,
+		ProvinceId:   p.Id,
+		ProvinceName: p.Name,
+		CountryId:    p.CountryId,
+		CountryName:  s.countries[p.CountryId].Name,
+	}
+}
+
+func (s *Store) mergeCountries(sourceId, targetId int, result *models.GeographyMergeResult) {
+	for _, id := range sortedIds(s.provinces) {
+		child := s.provinces[id]
+		if child.CountryId != sourceId {
+			continue
+		}
+		if twinId, ok := s.findProvince(targetId, child.Name); ok {
+			s.mergeProvinces(child.Id, twinId, result)
+		} else {
+			child.CountryId = targetId
+			s.provinces[child.Id] = child
+		}
+		result.Provinces++
+	}
+	delete(s.countries, sourceId)
+}
+
+func (s *Store) mergeProvinces(sourceId, targetId int, result *models.GeographyMergeResult) {
+	for _, id := range sortedIds(s.localities) {
+		child := s.localities[id]
+		if child.ProvinceId != sourceId {
+			continue
+		}
+		if twinId, ok := s.findLocality(targetId, child.Name); ok {
+			s.mergeLocalities(child.Id, twinId, result)
+		} else {
+			child.ProvinceId = targetId
+			s.localities[child.Id] = child
+		}
+		result.Localities++
+	}
+	delete(s.provinces, sourceId)
+}
+
+func (s *Store) mergeLocalities(sourceId, targetId int, result *models.GeographyMergeResult) {
+	for id, seller := range s.sellers {
+		if seller.LocalityID == sourceId {
+			seller.LocalityID = targetId
+			s.sellers[id] = seller
+			result.Sellers++
+		}
+	}
+	for id, carry := range s.carriers {
+		if carry.LocalityId == sourceId {
+			carry.LocalityId = targetId
+			s.carriers[id] = carry
+			result.Carriers++
+		}
+	}
+	for id, warehouse := range s.warehouses {
+		if warehouse.LocalityId == sourceId {
+			warehouse.LocalityId = targetId
+			s.warehouses[id] = warehouse
+			result.Warehouses++
+		}
+	}
+	delete(s.localities, sourceId)
+}
+
+// findProvince and findLocality match names by their normalized form, lowest id first
+// findProvince y findLocality comparan los nombres por su forma normalizada, primero el menor id
+func (s *Store) findProvince(countryId int, name string) (int, bool) {
+	for _, id := range sortedIds(s.provinces) {
+		if p := s.provinces[id]; p.CountryId == countryId && tools.NormalizeName(p.Name) == tools.NormalizeName(name) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (s *Store) findLocality(provinceId int, name string) (int, bool) {
+	for _, id := range sortedIds(s.localities) {
+		if l := s.localities[id]; l.ProvinceId == provinceId && tools.NormalizeName(l.Name) == tools.NormalizeName(name) {
+			return id, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// InboundOrderRepository - In-memory implementation of repositories.InboundOrderRepositoryI
+// InboundOrderRepository - Implementación en memoria de repositories.InboundOrderRepositoryI
+type InboundOrderRepository struct {
+	store *Store
+}
+
+// NewInboundOrderRepository - Creates an inbound order repository backed by the given store
+// NewInboundOrderRepository - Crea un repositorio de órdenes de entrada respaldado por el almacenamiento dado
+func NewInboundOrderRepository(store *Store) repositories.InboundOrderRepositoryI {
+	return &InboundOrderRepository{store: store}
+}
+
+func (r *InboundOrderRepository) GetAllInboundOrdersReports(ctx context.Context) ([]models.InboundOrderReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	reports := []models.InboundOrderReport{}
+	for _, id := range sortedIds(r.store.employees) {
+		if report, ok := r.report(id); ok {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (r *InboundOrderRepository) GetInboundOrdersReportByEmployeeId(ctx context.Context, employeeId int) (models.InboundOrderReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	// Employees without orders are not found, like the INNER JOIN of the MySQL query / Los empleados sin órdenes no se encuentran, como el INNER JOIN de la consulta MySQL
+	report, ok := r.report(employeeId)
+	if !ok {
+		return models.InboundOrderReport{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "employee with Id", employeeId, "doesn't exist.")
+	}
+	return report, nil
+}
+
+func (r *InboundOrderRepository) Create(ctx context.Context, inbound models.InboundOrder) (models.InboundOrder, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.employees[inbound.EmployeeId]; !ok {
+		return models.InboundOrder{}, errForeignKey("employee", inbound.EmployeeId)
+	}
+	if _, ok := r.store.productBatches[inbound.ProductBatchId]; !ok {
+		return models.InboundOrder{}, errForeignKey("product batch", inbound.ProductBatchId)
+	}
+	if _, ok := r.store.warehouses[inbound.WarehouseId]; !ok {
+		return models.InboundOrder{}, errForeignKey("warehouse", inbound.WarehouseId)
+	}
+
+	inbound.Id = r.store.nextId("inbound_orders")
+	r.store.inboundOrders[inbound.Id] = inbound
+	return inbound, nil
+}
+
+func (r *InboundOrderRepository) ExistsByOrderNumber(ctx context.Context, orderNumber string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, order := range r.store.inboundOrders {
+		if order.OrderNumber == orderNumber {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// report - Builds the report of an employee with at least one order; caller must hold the lock
+// report - Construye el reporte de un empleado con al menos una orden; quien llama debe tener el lock
+func (r *InboundOrderRepository) report(employeeId int) (models.InboundOrderReport, bool) {
+	employee, ok := r.store.employees[employeeId]
+	if !ok {
+		return models.InboundOrderReport{}, false
+	}
+
+	report := models.InboundOrderReport{Id: employee.Id, IdCardNumber: employee.CardNumberID, FirstName: employee.FirstName, LastName: employee.LastName}
+	for _, order := range r.store.inboundOrders {
+		if order.EmployeeId == employeeId {
+			report.InboundOrderCount++
+		}
+	}
+	return report, report.InboundOrderCount > 0
+}
@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
+)
+
+// LocalityRepository - In-memory implementation of repositories.LocalityRepository
+// LocalityRepository - Implementación en memoria de repositories.LocalityRepository
+type LocalityRepository struct {
+	store *Store
+}
+
+// NewLocalityRepository - Creates a locality repository backed by the given store
+// NewLocalityRepository - Crea un repositorio de localidades respaldado por el almacenamiento dado
+func NewLocalityRepository(store *Store) repositories.LocalityRepository {
+	return &LocalityRepository{store: store}
+}
+
+func (r *LocalityRepository) Save(ctx context.Context, loc models.Locality) (models.Locality, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// 1. Find or insert the country, matching normalized names / 1. Buscar o insertar el país, comparando nombres normalizados
+	countryId := 0
+	for _, id := range sortedIds(r.store.countries) {
+		if c := r.store.countries[id]; tools.NormalizeName(c.Name) == tools.NormalizeName(loc.CountryName) {
+			countryId = id
+			loc.CountryName = c.Name
+			break
+		}
+	}
+	if countryId == 0 {
+		countryId = r.store.nextId("countries")
+		r.store.countries[countryId] = country{Id: countryId, Name: loc.CountryName}
+	}
+
+	// 2. Find or insert the province / 2. Buscar o insertar la provincia
+	provinceId := 0
+	if id, ok := r.store.findProvince(countryId, loc.ProvinceName); ok {
+		provinceId = id
+		loc.ProvinceName = r.store.provinces[id].Name
+	}
+	if provinceId == 0 {
+		provinceId = r.store.nextId("provinces")
+		r.store.provinces[provinceId] = province{Id: provinceId, Name: loc.ProvinceName, CountryId: countryId}
+	}
+
+	// 3. Reject duplicates within the province / 3. Rechazar duplicados dentro de la provincia
+	if _, ok := r.store.findLocality(provinceId, loc.LocalityName); ok {
+		return models.Locality{}, error_message.ErrAlreadyExists
+	}
+
+	loc.Id = r.store.nextId("localities")
+	loc.ProvinceId = provinceId
+	loc.CountryId = countryId
+	r.store.localities[loc.Id] = locality{Id: loc.Id, Name: loc.LocalityName, ProvinceId: provinceId}
+	return loc, nil
+}
+
+func (r *LocalityRepository) GetLocalityCounts(ctx context.Context, level string, id int) ([]models.LocalityCounts, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := []models.LocalityCounts{}
+	for _, countryId := range sortedIds(r.store.countries) {
+		countryRow := models.LocalityCounts{CountryId: countryId, CountryName: r.store.countries[countryId].Name}
+		provinceIds := []int{}
+		for _, provinceId := range sortedIds(r.store.provinces) {
+			if r.store.provinces[provinceId].CountryId == countryId {
+				provinceIds = append(provinceIds, provinceId)
+			}
+		}
+		if len(provinceIds) == 0 {
+			counts = append(counts, countryRow)
+			continue
+		}
+
+		for _, provinceId := range provinceIds {
+			provinceRow := countryRow
+			provinceRow.ProvinceId, provinceRow.ProvinceName = provinceId, r.store.provinces[provinceId].Name
+			empty := true
+			for _, localityId := range sortedIds(r.store.localities) {
+				if r.store.localities[localityId].ProvinceId != provinceId {
+					continue
+				}
+				empty = false
+				row := provinceRow
+				row.LocalityId, row.LocalityName = localityId, r.store.localities[localityId].Name
+				for _, seller := range r.store.sellers {
+					if seller.LocalityID == localityId {
+						row.Sellers++
+					}
+				}
+				for _, carry := range r.store.carriers {
+					if carry.LocalityId == localityId {
+						row.Carriers++
+					}
+				}
+				for _, warehouse := range r.store.warehouses {
+					if warehouse.LocalityId == localityId {
+						row.Warehouses++
+					}
+				}
+				counts = append(counts, row)
+			}
+			if empty {
+				counts = append(counts, provinceRow)
+			}
+		}
+	}
+	if id == 0 {
+		return counts, nil
+	}
+
+	// Same rows the WHERE of the MySQL query keeps / Las mismas filas que conserva el WHERE de la consulta MySQL
+	filtered := []models.LocalityCounts{}
+	for _, row := range counts {
+		switch level {
+		case models.GeographyLevelLocality:
+			if row.LocalityId != id {
+				continue
+			}
+		case models.GeographyLevelProvince:
+			if row.ProvinceId != id {
+				continue
+			}
+		case models.GeographyLevelCountry:
+			if row.CountryId != id {
+				continue
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown level %q", error_message.ErrInvalidInput, level)
+		}
+		filtered = append(filtered, row)
+	}
+	return filtered, nil
+}
+
+func (r *LocalityRepository) ExistById(ctx context.Context, localityID int) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.localities[localityID]
+	return ok, nil
+}
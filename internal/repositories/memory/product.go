@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// ProductRepository - In-memory implementation of repositories.ProductRepository
+// ProductRepository - Implementación en memoria de repositories.ProductRepository
+type ProductRepository struct {
+	store *Store
+}
+
+// NewProductRepository - Creates a product repository backed by the given store
+// NewProductRepository - Crea un repositorio de productos respaldado por el almacenamiento dado
+func NewProductRepository(store *Store) repositories.ProductRepository {
+	return &ProductRepository{store: store}
+}
+
+func (r *ProductRepository) GetAll(ctx context.Context) ([]models.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var products []models.Product
+	for _, id := range sortedIds(r.store.products) {
+		products = append(products, r.store.products[id])
+	}
+	return products, nil
+}
+
+func (r *ProductRepository) GetByID(ctx context.Context, id int64) (models.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	product, ok := r.store.products[int(id)]
+	if !ok {
+		return models.Product{}, error_message.ErrNotFound
+	}
+	return product, nil
+}
+
+func (r *ProductRepository) Create(ctx context.Context, newProduct models.Product) (models.Product, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return r.insert(newProduct)
+}
+
+func (r *ProductRepository) CreateByBatch(ctx context.Context, products []models.Product) ([]models.Product, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Validate every product first so the batch is all or nothing / Validar todos los productos primero para que el lote sea todo o nada
+	for _, product := range products {
+		if err := r.checkReferences(product); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range products {
+		created, err := r.insert(products[i])
+		if err != nil {
+			return nil, err
+		}
+		products[i] = created
+	}
+	return products, nil
+}
+
+func (r *ProductRepository) Update(ctx context.Context, id int64, product models.Product) (models.Product, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[int(id)]; !ok {
+		return models.Product{}, error_message.ErrNotFound
+	}
+	if err := r.checkReferences(product); err != nil {
+		return models.Product{}, err
+	}
+
+	product.Id = id
+	r.store.products[int(id)] = product
+	return product, nil
+}
+
+func (r *ProductRepository) Delete(ctx context.Context, id int64) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[int(id)]; !ok {
+		return error_message.ErrNotFound
+	}
+	r.store.deleteProduct(int(id))
+	return nil
+}
+
+func (r *ProductRepository) Exists(ctx context.Context, id int64) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.products[int(id)]
+	return ok, nil
+}
+
+func (r *ProductRepository) ExistsByProductCode(ctx context.Context, productCode string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, product := range r.store.products {
+		if product.ProductCode == productCode {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// insert - Stores a new product; caller must hold the write lock
+// insert - Guarda un nuevo producto; quien llama debe tener el lock de escritura
+func (r *ProductRepository) insert(product models.Product) (models.Product, error) {
+	if err := r.checkReferences(product); err != nil {
+		return models.Product{}, err
+	}
+
+	product.Id = int64(r.store.nextId("products"))
+	r.store.products[int(product.Id)] = product
+	return product, nil
+}
+
+// checkReferences - Emulates the product type and optional seller foreign keys; caller must hold the lock
+// checkReferences - Emula las claves foráneas de tipo de producto y vendedor opcional; quien llama debe tener el lock
+func (r *ProductRepository) checkReferences(product models.Product) error {
+	if _, ok := r.store.productTypes[int(product.ProductTypeID)]; !ok {
+		return errForeignKey("product type", int(product.ProductTypeID))
+	}
+	if product.SellerID == nil {
+		return nil
+	}
+	if _, ok := r.store.sellers[int(*product.SellerID)]; !ok {
+		return errForeignKey("seller", int(*product.SellerID))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// ProductBatchRepository - In-memory implementation of repositories.ProductBatchRepositoryI
+// ProductBatchRepository - Implementación en memoria de repositories.ProductBatchRepositoryI
+type ProductBatchRepository struct {
+	store *Store
+}
+
+// NewProductBatchRepository - Creates a product batch repository backed by the given store
+// NewProductBatchRepository - Crea un repositorio de lotes de productos respaldado por el almacenamiento dado
+func NewProductBatchRepository(store *Store) repositories.ProductBatchRepositoryI {
+	return &ProductBatchRepository{store: store}
+}
+
+func (r *ProductBatchRepository) Create(ctx context.Context, model *models.ProductBatch) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[model.ProductID]; !ok {
+		return errForeignKey("product", model.ProductID)
+	}
+	if _, ok := r.store.sections[model.SectionID]; !ok {
+		return errForeignKey("section", model.SectionID)
+	}
+
+	model.Id = r.store.nextId("product_batches")
+	r.store.productBatches[model.Id] = *model
+	return nil
+}
+
+func (r *ProductBatchRepository) GetProductQuantityBySectionId(ctx context.Context, id int) int {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	quantity := 0
+	for _, batch := range r.store.productBatches {
+		if batch.SectionID == id {
+			quantity += batch.CurrentQuantity
+		}
+	}
+	return quantity
+}
+
+func (r *ProductBatchRepository) ExistsWithBatchNumber(ctx context.Context, id int, batchNumber string) bool {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, batch := range r.store.productBatches {
+		if batch.BatchNumber == batchNumber && batch.Id != id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ProductBatchRepository) GetSectionOccupancy(ctx context.Context, sectionId int) (models.SectionOccupancy, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var occupancy models.SectionOccupancy
+	for _, batch := range r.store.productBatches {
+		if batch.SectionID == sectionId {
+			occupancy = occupancy.Add(r.store.products[batch.ProductID], batch.CurrentQuantity)
+		}
+	}
+	return occupancy, nil
+}
+
+func (r *ProductBatchRepository) CountDueBefore(ctx context.Context, before time.Time) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	count := 0
+	for _, batch := range r.store.productBatches {
+		if batch.CurrentQuantity > 0 && batch.DueDate.Before(before) {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// ProductRecordRepository - In-memory implementation of repositories.IProductRecordRepository
+// ProductRecordRepository - Implementación en memoria de repositories.IProductRecordRepository
+type ProductRecordRepository struct {
+	store *Store
+}
+
+// NewProductRecordRepository - Creates a product record repository backed by the given store
+// NewProductRecordRepository - Crea un repositorio de registros de productos respaldado por el almacenamiento dado
+func NewProductRecordRepository(store *Store) repositories.IProductRecordRepository {
+	return &ProductRecordRepository{store: store}
+}
+
+func (r *ProductRecordRepository) Create(ctx context.Context, pr *models.ProductRecord) (*models.ProductRecord, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.products[int(pr.ProductID)]; !ok {
+		return nil, errForeignKey("product", int(pr.ProductID))
+	}
+
+	pr.ID = r.store.nextId("product_records")
+	r.store.productRecords[pr.ID] = *pr
+	return pr, nil
+}
+
+func (r *ProductRecordRepository) GetReportByIdProduct(ctx context.Context, id int64) (*models.ProductRecordReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	product, ok := r.store.products[int(id)]
+	if !ok {
+		return nil, error_message.ErrDependencyNotFound
+	}
+
+	report := r.report(product)
+	return &report, nil
+}
+
+func (r *ProductRecordRepository) GetReport(ctx context.Context) ([]*models.ProductRecordReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	reports := []*models.ProductRecordReport{}
+	for _, id := range sortedIds(r.store.products) {
+		report := r.report(r.store.products[id])
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
+func (r *ProductRecordRepository) ExistProductRecordByID(ctx context.Context, id int64) bool {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.productRecords[int(id)]
+	return ok
+}
+
+// report - Counts the records of a product; caller must hold the lock
+// report - Cuenta los registros de un producto; quien llama debe tener el lock
+func (r *ProductRecordRepository) report(product models.Product) models.ProductRecordReport {
+	report := models.ProductRecordReport{ProductId: product.Id, Description: product.Description}
+	for _, record := range r.store.productRecords {
+		if record.ProductID == product.Id {
+			report.RecordsCount++
+		}
+	}
+	return report
+}
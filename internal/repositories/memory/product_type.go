@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// ProductTypeRepository - In-memory implementation of repositories.ProductTypeRepository
+// ProductTypeRepository - Implementación en memoria de repositories.ProductTypeRepository
+type ProductTypeRepository struct {
+	store *Store
+}
+
+// NewProductTypeRepository - Creates a product type repository backed by the given store
+// NewProductTypeRepository - Crea un repositorio de tipos de producto respaldado por el almacenamiento dado
+func NewProductTypeRepository(store *Store) repositories.ProductTypeRepository {
+	return &ProductTypeRepository{store: store}
+}
+
+func (r *ProductTypeRepository) GetAll(ctx context.Context) ([]models.ProductType, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	productTypes := []models.ProductType{}
+	for _, id := range sortedIds(r.store.productTypes) {
+		productTypes = append(productTypes, r.store.productTypes[id])
+	}
+	return productTypes, nil
+}
+
+func (r *ProductTypeRepository) GetById(ctx context.Context, id int) (models.ProductType, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	productType, ok := r.store.productTypes[id]
+	if !ok {
+		return models.ProductType{}, fmt.Errorf("%w: product type with id %d", error_message.ErrNotFound, id)
+	}
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Create(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	productType.Id = r.store.nextId("products_types")
+	r.store.productTypes[productType.Id] = productType
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Update(ctx context.Context, id int, productType models.ProductType) (models.ProductType, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	productType.Id = id
+	if _, ok := r.store.productTypes[id]; ok {
+		r.store.productTypes[id] = productType
+	}
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Delete(ctx context.Context, id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.productTypes[id]; !ok {
+		return fmt.Errorf("%w: product type with id %d", error_message.ErrNotFound, id)
+	}
+	r.store.deleteProductType(id)
+	return nil
+}
+
+func (r *ProductTypeRepository) ExistsByDescription(ctx context.Context, description string, excludeId int) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for id, productType := range r.store.productTypes {
+		if id != excludeId && productType.Description == description {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *ProductTypeRepository) CountDependents(ctx context.Context, id int) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	count := 0
+	for _, section := range r.store.sections {
+		if section.ProductTypeID == id {
+			count++
+		}
+	}
+	for _, product := range r.store.products {
+		if int(product.ProductTypeID) == id {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// PurchaseOrderRepository - In-memory implementation of repositories.PurchaseOrderRepositoryI
+// PurchaseOrderRepository - Implementación en memoria de repositories.PurchaseOrderRepositoryI
+type PurchaseOrderRepository struct {
+	store *Store
+}
+
+// NewPurchaseOrderRepository - Creates a purchase order repository backed by the given store
+// NewPurchaseOrderRepository - Crea un repositorio de órdenes de compra respaldado por el almacenamiento dado
+func NewPurchaseOrderRepository(store *Store) repositories.PurchaseOrderRepositoryI {
+	return &PurchaseOrderRepository{store: store}
+}
+
+func (r *PurchaseOrderRepository) GetAll(ctx context.Context) (map[int]models.PurchaseOrder, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	orders := make(map[int]models.PurchaseOrder, len(r.store.purchaseOrders))
+	for id, order := range r.store.purchaseOrders {
+		orders[id] = order
+	}
+	return orders, nil
+}
+
+func (r *PurchaseOrderRepository) Create(ctx context.Context, order models.PurchaseOrder) (models.PurchaseOrder, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.buyers[order.BuyerId]; !ok {
+		return models.PurchaseOrder{}, errForeignKey("buyer", order.BuyerId)
+	}
+	if _, ok := r.store.productRecords[order.ProductRecordId]; !ok {
+		return models.PurchaseOrder{}, errForeignKey("product record", order.ProductRecordId)
+	}
+
+	order.Id = r.store.nextId("purchase_orders")
+	r.store.purchaseOrders[order.Id] = order
+	return order, nil
+}
+
+func (r *PurchaseOrderRepository) ExistPurchaseOrderByOrderNumber(ctx context.Context, orderNumber string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, order := range r.store.purchaseOrders {
+		if order.OrderNumber == orderNumber {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *PurchaseOrderRepository) GetPurchaseOrdersReportByBuyerId(ctx context.Context, buyerId int) (models.PurchaseOrderReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	// Buyers without orders are not found, like the INNER JOIN of the MySQL query / Los compradores sin órdenes no se encuentran, como el INNER JOIN de la consulta MySQL
+	report, ok := r.report(buyerId)
+	if !ok {
+		return models.PurchaseOrderReport{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Buyer with Id", buyerId, "doesn't exists.")
+	}
+	return report, nil
+}
+
+func (r *PurchaseOrderRepository) GetAllPurchaseOrdersReports(ctx context.Context) ([]models.PurchaseOrderReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	reports := []models.PurchaseOrderReport{}
+	for _, id := range sortedIds(r.store.buyers) {
+		if report, ok := r.report(id); ok {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+// report - Builds the report of a buyer with at least one order; caller must hold the lock
+// report - Construye el reporte de un comprador con al menos una orden; quien llama debe tener el lock
+func (r *PurchaseOrderRepository) report(buyerId int) (models.PurchaseOrderReport, bool) {
+	buyer, ok := r.store.buyers[buyerId]
+	if !ok {
+		return models.PurchaseOrderReport{}, false
+	}
+
+	report := models.PurchaseOrderReport{Id: buyer.Id, IdCardNumber: buyer.CardNumberId, FirstName: buyer.FirstName, LastName: buyer.LastName}
+	for _, order := range r.store.purchaseOrders {
+		if order.BuyerId == buyerId {
+			report.PurchaseOrderCount++
+		}
+	}
+	return report, report.PurchaseOrderCount > 0
+}
@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// SectionRepository - In-memory implementation of repositories.SectionRepositoryI
+// SectionRepository - Implementación en memoria de repositories.SectionRepositoryI
+type SectionRepository struct {
+	store *Store
+}
+
+// NewSectionRepository - Creates a section repository backed by the given store
+// NewSectionRepository - Crea un repositorio de secciones respaldado por el almacenamiento dado
+func NewSectionRepository(store *Store) repositories.SectionRepositoryI {
+	return &SectionRepository{store: store}
+}
+
+func (r *SectionRepository) GetAll(ctx context.Context) ([]*models.Section, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	sections := []*models.Section{}
+	for _, id := range sortedIds(r.store.sections) {
+		section := r.store.sections[id]
+		sections = append(sections, &section)
+	}
+	return sections, nil
+}
+
+func (r *SectionRepository) GetByID(ctx context.Context, id int) (*models.Section, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	section, ok := r.store.sections[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: section with id %d", error_message.ErrNotFound, id)
+	}
+	return &section, nil
+}
+
+func (r *SectionRepository) Create(ctx context.Context, model *models.Section) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if err := r.checkReferences(model); err != nil {
+		return err
+	}
+
+	model.Id = r.store.nextId("sections")
+	r.store.sections[model.Id] = *model
+	return nil
+}
+
+func (r *SectionRepository) Update(ctx context.Context, model *models.Section) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Updating a missing id affects no rows, like the MySQL repository / Actualizar un id inexistente no afecta filas, como en el repositorio MySQL
+	if _, ok := r.store.sections[model.Id]; !ok {
+		return nil
+	}
+	if err := r.checkReferences(model); err != nil {
+		return err
+	}
+
+	r.store.sections[model.Id] = *model
+	return nil
+}
+
+func (r *SectionRepository) ExistWithID(ctx context.Context, id int) bool {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.sections[id]
+	return ok
+}
+
+func (r *SectionRepository) ExistsWithSectionNumber(ctx context.Context, id int, sectionNumber string) bool {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, section := range r.store.sections {
+		if section.SectionNumber == sectionNumber && section.Id != id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *SectionRepository) DeleteByID(ctx context.Context, id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.deleteSection(id)
+	return nil
+}
+
+func (r *SectionRepository) CountDependents(ctx context.Context, id int) (models.DeleteImpact, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var impact models.DeleteImpact
+	batches := map[int]bool{}
+	for _, batch := range r.store.productBatches {
+		if batch.SectionID == id {
+			batches[batch.Id] = true
+			impact.ProductBatches++
+		}
+	}
+	for _, order := range r.store.inboundOrders {
+		if batches[order.ProductBatchId] {
+			impact.InboundOrders++
+		}
+	}
+	return impact, nil
+}
+
+// checkReferences - Emulates the warehouse and product type foreign keys; caller must hold the lock
+// checkReferences - Emula las claves foráneas de almacén y tipo de producto; quien llama debe tener el lock
+func (r *SectionRepository) checkReferences(model *models.Section) error {
+	if _, ok := r.store.warehouses[model.WarehouseID]; !ok {
+		return errForeignKey("warehouse", model.WarehouseID)
+	}
+	if _, ok := r.store.productTypes[model.ProductTypeID]; !ok {
+		return errForeignKey("product type", model.ProductTypeID)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// SellerRepository - In-memory implementation of repositories.SellerRepository
+// SellerRepository - Implementación en memoria de repositories.SellerRepository
+type SellerRepository struct {
+	store *Store
+}
+
+// NewSellerRepository - Creates a seller repository backed by the given store
+// NewSellerRepository - Crea un repositorio de vendedores respaldado por el almacenamiento dado
+func NewSellerRepository(store *Store) repositories.SellerRepository {
+	return &SellerRepository{store: store}
+}
+
+func (r *SellerRepository) GetAll(ctx context.Context) ([]models.Seller, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var sellers []models.Seller
+	for _, id := range sortedIds(r.store.sellers) {
+		sellers = append(sellers, r.store.sellers[id])
+	}
+	return sellers, nil
+}
+
+func (r *SellerRepository) GetById(ctx context.Context, id int) (models.Seller, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	seller, ok := r.store.sellers[id]
+	if !ok {
+		return models.Seller{}, fmt.Errorf("%w: seller with id %d", error_message.ErrNotFound, id)
+	}
+	return seller, nil
+}
+
+func (r *SellerRepository) Save(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.localities[seller.LocalityID]; !ok {
+		return models.Seller{}, errForeignKey("locality", seller.LocalityID)
+	}
+
+	seller.Id = r.store.nextId("sellers")
+	r.store.sellers[seller.Id] = seller
+	return seller, nil
+}
+
+func (r *SellerRepository) Update(ctx context.Context, id int, seller models.Seller) (models.Seller, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Updating a missing row affects nothing, like the MySQL UPDATE / Actualizar una fila inexistente no afecta nada, como el UPDATE de MySQL
+	if _, ok := r.store.sellers[id]; !ok {
+		seller.Id = id
+		return seller, nil
+	}
+	if _, ok := r.store.localities[seller.LocalityID]; !ok {
+		return models.Seller{}, errForeignKey("locality", seller.LocalityID)
+	}
+
+	seller.Id = id
+	r.store.sellers[id] = seller
+	return seller, nil
+}
+
+func (r *SellerRepository) Delete(ctx context.Context, id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.sellers[id]; !ok {
+		return fmt.Errorf("%w: seller with id %d", error_message.ErrNotFound, id)
+	}
+	r.store.deleteSeller(id)
+	return nil
+}
+
+func (r *SellerRepository) ExistsByCid(ctx context.Context, cid string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, seller := range r.store.sellers {
+		if seller.CID == cid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *SellerRepository) GetProducts(ctx context.Context, sellerId int) ([]models.Product, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	products := []models.Product{}
+	for _, id := range sortedIds(r.store.products) {
+		product := r.store.products[id]
+		if product.SellerID != nil && int(*product.SellerID) == sellerId {
+			products = append(products, product)
+		}
+	}
+	return products, nil
+}
+
+func (r *SellerRepository) GetReport(ctx context.Context, sellerId int, from, to *time.Time) (models.SellerReport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	seller, ok := r.store.sellers[sellerId]
+	if !ok {
+		return models.SellerReport{}, fmt.Errorf("%w: seller with id %d", error_message.ErrNotFound, sellerId)
+	}
+
+	report := models.SellerReport{SellerId: seller.Id, CompanyName: seller.CompanyName, From: from, To: to}
+	owned := func(productId int64) bool {
+		product, ok := r.store.products[int(productId)]
+		return ok && product.SellerID != nil && int(*product.SellerID) == sellerId
+	}
+
+	for _, product := range r.store.products {
+		if owned(product.Id) {
+			report.ProductsCount++
+		}
+	}
+	for _, batch := range r.store.productBatches {
+		if owned(int64(batch.ProductID)) {
+			report.BatchStock += batch.CurrentQuantity
+		}
+	}
+	for _, order := range r.store.purchaseOrders {
+		record := r.store.productRecords[order.ProductRecordId]
+		if !owned(record.ProductID) {
+			continue
+		}
+		if from != nil && order.OrderDate.Before(*from) {
+			continue
+		}
+		if to != nil && !order.OrderDate.Before(to.AddDate(0, 0, 1)) {
+			continue
+		}
+		report.UnitsSold++
+		report.Revenue += record.SalePrice
+	}
+	return report, nil
+}
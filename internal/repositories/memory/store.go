@@ -0,0 +1,201 @@
+package memory
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+// country, province and locality rows mirror the geographic tables of the schema
+// las filas country, province y locality reflejan las tablas geográficas del esquema
+type country struct {
+	Id   int
+	Name string
+}
+
+type province struct {
+	Id        int
+	Name      string
+	CountryId int
+}
+
+type locality struct {
+	Id         int
+	Name       string
+	ProvinceId int
+}
+
+// Store - In-memory data shared by every memory repository, emulating the MySQL tables
+// Foreign keys are checked on write and deletes cascade like the ON DELETE CASCADE constraints
+// Store - Datos en memoria compartidos por todos los repositorios en memoria, emulando las tablas de MySQL
+// Las claves foráneas se verifican al escribir y los borrados se propagan como las restricciones ON DELETE CASCADE
+type Store struct {
+	mu sync.RWMutex
+
+	countries      map[int]country
+	provinces      map[int]province
+	localities     map[int]locality
+	sellers        map[int]models.Seller
+	buyers         map[int]models.Buyer
+	warehouses     map[int]models.Warehouse
+	productTypes   map[int]models.ProductType
+	employees      map[int]models.Employee
+	sections       map[int]models.Section
+	products       map[int]models.Product
+	productRecords map[int]models.ProductRecord
+	productBatches map[int]models.ProductBatch
+	inboundOrders  map[int]models.InboundOrder
+	carriers       map[int]models.Carry
+	purchaseOrders map[int]models.PurchaseOrder
+
+	lastIds map[string]int // Auto increment counters per table / Contadores auto incrementales por tabla
+}
+
+// NewStore - Creates an empty in-memory store
+// NewStore - Crea un almacenamiento en memoria vacío
+func NewStore() *Store {
+	return &Store{
+		countries:      map[int]country{},
+		provinces:      map[int]province{},
+		localities:     map[int]locality{},
+		sellers:        map[int]models.Seller{},
+		buyers:         map[int]models.Buyer{},
+		warehouses:     map[int]models.Warehouse{},
+		productTypes:   map[int]models.ProductType{},
+		employees:      map[int]models.Employee{},
+		sections:       map[int]models.Section{},
+		products:       map[int]models.Product{},
+		productRecords: map[int]models.ProductRecord{},
+		productBatches: map[int]models.ProductBatch{},
+		inboundOrders:  map[int]models.InboundOrder{},
+		carriers:       map[int]models.Carry{},
+		purchaseOrders: map[int]models.PurchaseOrder{},
+		lastIds:        map[string]int{},
+	}
+}
+
+// nextId - Returns the next auto increment id for a table; caller must hold the write lock
+// nextId - Retorna el siguiente id auto incremental de una tabla; quien llama debe tener el lock de escritura
+func (s *Store) nextId(table string) int {
+	s.lastIds[table]++
+	return s.lastIds[table]
+}
+
+// sortedIds - Returns the keys of a table ordered like a primary key scan
+// sortedIds - Retorna las claves de una tabla ordenadas como un recorrido por clave primaria
+func sortedIds[T any](table map[int]T) []int {
+	return slices.Sorted(maps.Keys(table))
+}
+
+// errForeignKey - Emulates a foreign key violation for a missing parent row
+// errForeignKey - Emula una violación de clave foránea por una fila padre inexistente
+func errForeignKey(table string, id int) error {
+	return fmt.Errorf("%w: %s with id %d does not exist", error_message.ErrDependencyNotFound, table, id)
+}
+
+// Cascade helpers - caller must hold the write lock / Helpers de cascada - quien llama debe tener el lock de escritura
+
+func (s *Store) deleteSeller(id int) {
+	delete(s.sellers, id)
+	for productId, product := range s.products {
+		if product.SellerID != nil && int(*product.SellerID) == id {
+			s.deleteProduct(productId)
+		}
+	}
+}
+
+func (s *Store) deleteBuyer(id int) {
+	delete(s.buyers, id)
+	for orderId, order := range s.purchaseOrders {
+		if order.BuyerId == id {
+			delete(s.purchaseOrders, orderId)
+		}
+	}
+}
+
+func (s *Store) deleteWarehouse(id int) {
+	delete(s.warehouses, id)
+	for employeeId, employee := range s.employees {
+		if employee.WarehouseID == id {
+			s.deleteEmployee(employeeId)
+		}
+	}
+	for sectionId, section := range s.sections {
+		if section.WarehouseID == id {
+			s.deleteSection(sectionId)
+		}
+	}
+	for orderId, order := range s.inboundOrders {
+		if order.WarehouseId == id {
+			delete(s.inboundOrders, orderId)
+		}
+	}
+}
+
+func (s *Store) deleteProductType(id int) {
+	delete(s.productTypes, id)
+	for sectionId, section := range s.sections {
+		if section.ProductTypeID == id {
+			s.deleteSection(sectionId)
+		}
+	}
+	for productId, product := range s.products {
+		if int(product.ProductTypeID) == id {
+			s.deleteProduct(productId)
+		}
+	}
+}
+
+func (s *Store) deleteEmployee(id int) {
+	delete(s.employees, id)
+	for orderId, order := range s.inboundOrders {
+		if order.EmployeeId == id {
+			delete(s.inboundOrders, orderId)
+		}
+	}
+}
+
+func (s *Store) deleteSection(id int) {
+	delete(s.sections, id)
+	for batchId, batch := range s.productBatches {
+		if batch.SectionID == id {
+			s.deleteProductBatch(batchId)
+		}
+	}
+}
+
+func (s *Store) deleteProduct(id int) {
+	delete(s.products, id)
+	for recordId, record := range s.productRecords {
+		if int(record.ProductID) == id {
+			s.deleteProductRecord(recordId)
+		}
+	}
+	for batchId, batch := range s.productBatches {
+		if batch.ProductID == id {
+			s.deleteProductBatch(batchId)
+		}
+	}
+}
+
+func (s *Store) deleteProductRecord(id int) {
+	delete(s.productRecords, id)
+	for orderId, order := range s.purchaseOrders {
+		if order.ProductRecordId == id {
+			delete(s.purchaseOrders, orderId)
+		}
+	}
+}
+
+func (s *Store) deleteProductBatch(id int) {
+	delete(s.productBatches, id)
+	for orderId, order := range s.inboundOrders {
+		if order.ProductBatchId == id {
+			delete(s.inboundOrders, orderId)
+		}
+	}
+}
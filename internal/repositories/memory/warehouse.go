@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+)
+
+// WarehouseRepository - In-memory implementation of repositories.WarehouseRepository
+// WarehouseRepository - Implementación en memoria de repositories.WarehouseRepository
+type WarehouseRepository struct {
+	store *Store
+}
+
+// NewWarehouseRepository - Creates a warehouse repository backed by the given store
+// NewWarehouseRepository - Crea un repositorio de almacenes respaldado por el almacenamiento dado
+func NewWarehouseRepository(store *Store) repositories.WarehouseRepository {
+	return &WarehouseRepository{store: store}
+}
+
+func (r *WarehouseRepository) GetAll(ctx context.Context) ([]models.Warehouse, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	warehouses := []models.Warehouse{}
+	for _, id := range sortedIds(r.store.warehouses) {
+		warehouses = append(warehouses, r.store.warehouses[id])
+	}
+	return warehouses, nil
+}
+
+func (r *WarehouseRepository) Create(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.localities[warehouse.LocalityId]; !ok {
+		return models.Warehouse{}, errForeignKey("locality", warehouse.LocalityId)
+	}
+
+	warehouse.Id = r.store.nextId("warehouse")
+	r.store.warehouses[warehouse.Id] = warehouse
+	return warehouse, nil
+}
+
+func (r *WarehouseRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, warehouse := range r.store.warehouses {
+		if warehouse.WareHouseCode == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *WarehouseRepository) GetById(ctx context.Context, id int) (models.Warehouse, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	warehouse, ok := r.store.warehouses[id]
+	if !ok {
+		return models.Warehouse{}, fmt.Errorf("%w: warehouse with id %d", error_message.ErrNotFound, id)
+	}
+	return warehouse, nil
+}
+
+func (r *WarehouseRepository) Delete(ctx context.Context, id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.deleteWarehouse(id)
+	return nil
+}
+
+func (r *WarehouseRepository) Update(ctx context.Context, id int, warehouse models.Warehouse) (models.Warehouse, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, ok := r.store.warehouses[id]
+	if !ok {
+		return models.Warehouse{}, fmt.Errorf("%w: warehouse with id %d", error_message.ErrNotFound, id)
+	}
+
+	// The locality is not part of the update, like the MySQL repository / La localidad no forma parte de la actualización, como en el repositorio MySQL
+	warehouse.Id = id
+	warehouse.LocalityId = existing.LocalityId
+	r.store.warehouses[id] = warehouse
+	return warehouse, nil
+}
+
+func (r *WarehouseRepository) CountDependents(ctx context.Context, id int) (models.DeleteImpact, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var impact models.DeleteImpact
+	sections := map[int]bool{}
+	for _, section := range r.store.sections {
+		if section.WarehouseID == id {
+			sections[section.Id] = true
+			impact.Sections++
+		}
+	}
+
+	batches := map[int]bool{}
+	for _, batch := range r.store.productBatches {
+		if sections[batch.SectionID] {
+			batches[batch.Id] = true
+			impact.ProductBatches++
+		}
+	}
+
+	employees := map[int]bool{}
+	for _, employee := range r.store.employees {
+		if employee.WarehouseID == id {
+			employees[employee.Id] = true
+			impact.Employees++
+		}
+	}
+
+	for _, order := range r.store.inboundOrders {
+		if order.WarehouseId == id || employees[order.EmployeeId] || batches[order.ProductBatchId] {
+			impact.InboundOrders++
+		}
+	}
+
+	return impact, nil
+}
+
+func (r *WarehouseRepository) GetStock(ctx context.Context, id int) ([]models.WarehouseStockRow, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stock := []models.WarehouseStockRow{}
+	for _, sectionId := range sortedIds(r.store.sections) {
+		section := r.store.sections[sectionId]
+		if section.WarehouseID != id {
+			continue
+		}
+
+		// Group the section batches by product like the GROUP BY of the MySQL query
+		// Agrupar los lotes de la sección por producto como el GROUP BY de la consulta MySQL
+		byProduct := map[int]models.WarehouseStockRow{}
+		for _, batchId := range sortedIds(r.store.productBatches) {
+			batch := r.store.productBatches[batchId]
+			if batch.SectionID != sectionId {
+				continue
+			}
+
+			row, ok := byProduct[batch.ProductID]
+			if !ok {
+				product := r.store.products[batch.ProductID]
+				row = models.WarehouseStockRow{
+					ProductId:          batch.ProductID,
+					ProductCode:        product.ProductCode,
+					ProductDescription: product.Description,
+				}
+			}
+			occupancy := models.SectionOccupancy{}.Add(r.store.products[batch.ProductID], batch.CurrentQuantity)
+			row.Batches++
+			row.Quantity += occupancy.Units
+			row.Volume += occupancy.Volume
+			row.Weight += occupancy.Weight
+			if row.EarliestDueDate == nil || batch.DueDate.Before(*row.EarliestDueDate) {
+				dueDate := batch.DueDate
+				row.EarliestDueDate = &dueDate
+			}
+			byProduct[batch.ProductID] = row
+		}
+
+		if len(byProduct) == 0 {
+			byProduct[0] = models.WarehouseStockRow{}
+		}
+		for _, productId := range sortedIds(byProduct) {
+			row := byProduct[productId]
+			row.SectionId = section.Id
+			row.SectionNumber = section.SectionNumber
+			row.MaximumCapacity = section.MaximumCapacity
+			row.MaximumVolume = section.MaximumVolume
+			row.MaximumWeight = section.MaximumWeight
+			stock = append(stock, row)
+		}
+	}
+	return stock, nil
+}
+
+func (r *WarehouseRepository) GetStockTotals(ctx context.Context) ([]models.WarehouseStockTotal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	quantities := map[int]int{}
+	for _, batch := range r.store.productBatches {
+		quantities[r.store.sections[batch.SectionID].WarehouseID] += batch.CurrentQuantity
+	}
+
+	totals := []models.WarehouseStockTotal{}
+	for _, id := range sortedIds(r.store.warehouses) {
+		totals = append(totals, models.WarehouseStockTotal{
+			WarehouseId:   id,
+			WarehouseCode: r.store.warehouses[id].WareHouseCode,
+			Quantity:      quantities[id],
+		})
+	}
+	return totals, nil
+}
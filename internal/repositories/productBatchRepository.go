@@ -3,25 +3,21 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/pkg/database"
 )
 
-var productBatchRepositoryInstance ProductBatchRepositoryI
-
-// GetProductBatchRepository - Creates and returns a new instance of productBatchRepository using singleton pattern
-// GetProductBatchRepository - Crea y retorna una nueva instancia de productBatchRepository usando patrón singleton
+// GetProductBatchRepository - Creates and returns a new instance of productBatchRepository
+// GetProductBatchRepository - Crea y retorna una nueva instancia de productBatchRepository
 func GetProductBatchRepository(db *sql.DB) ProductBatchRepositoryI {
-	if productBatchRepositoryInstance != nil {
-		return productBatchRepositoryInstance
-	}
-
-	productBatchRepositoryInstance = &productBatchRepository{
+	return &productBatchRepository{
 		database:  db,
 		tablename: "product_batches",
 	}
-	return productBatchRepositoryInstance
 }
 
 // ProductBatchRepositoryI - Interface defining the contract for product batch repository operations
@@ -38,6 +34,14 @@ type ProductBatchRepositoryI interface {
 	// ExistsWithBatchNumber - Checks if a product batch exists with the given batch number, excluding a specific ID
 	// ExistsWithBatchNumber - Verifica si existe un lote de producto con el número de lote dado, excluyendo un ID específico
 	ExistsWithBatchNumber(ctx context.Context, id int, batchNumber string) bool
+
+	// GetSectionOccupancy - Retrieves the units, volume and weight taken by the batches of a section
+	// GetSectionOccupancy - Obtiene las unidades, el volumen y el peso ocupados por los lotes de una sección
+	GetSectionOccupancy(ctx context.Context, sectionId int) (models.SectionOccupancy, error)
+
+	// CountDueBefore - Counts the batches with stock left whose due date is before the given time
+	// CountDueBefore - Cuenta los lotes con stock restante cuya fecha de vencimiento es anterior al momento dado
+	CountDueBefore(ctx context.Context, before time.Time) (int, error)
 }
 
 // productBatchRepository - Implementation of ProductBatchRepositoryI using a generic database helper
@@ -107,3 +111,29 @@ func (r *productBatchRepository) GetProductQuantityBySectionId(ctx context.Conte
 
 	return quantity
 }
+
+// GetSectionOccupancy - Sums quantity, volume (width × height × length × quantity) and weight (net weight × quantity) of the section batches
+// GetSectionOccupancy - Suma cantidad, volumen (ancho × alto × largo × cantidad) y peso (peso neto × cantidad) de los lotes de la sección
+func (r *productBatchRepository) GetSectionOccupancy(ctx context.Context, sectionId int) (models.SectionOccupancy, error) {
+	sqlStatement := fmt.Sprintf("SELECT COALESCE(SUM(pb.`current_quantity`), 0), "+
+		"COALESCE(SUM(pb.`current_quantity` * p.`width` * p.`height` * p.`length`), 0), "+
+		"COALESCE(SUM(pb.`current_quantity` * p.`net_weight`), 0) "+
+		"FROM %s pb INNER JOIN `products` p ON p.`id` = pb.`product_id` WHERE pb.`section_id` = ?", r.tablename)
+
+	var occupancy models.SectionOccupancy
+	if err := r.database.QueryRowContext(ctx, sqlStatement, sectionId).Scan(&occupancy.Units, &occupancy.Volume, &occupancy.Weight); err != nil {
+		return models.SectionOccupancy{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return occupancy, nil
+}
+
+// CountDueBefore - Counts the batches with current quantity above zero and due date before the given time
+// CountDueBefore - Cuenta los lotes con cantidad actual mayor a cero y fecha de vencimiento anterior al momento dado
+func (r *productBatchRepository) CountDueBefore(ctx context.Context, before time.Time) (int, error) {
+	row := database.SelectOne(ctx, r.database, r.tablename, []string{"COUNT(*)"}, "`current_quantity` > 0 AND `due_date` < ?", before)
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return count, nil
+}
@@ -10,17 +10,10 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
-var productRecordInstance IProductRecordRepository
-
 // NewProductRecordRepository - Constructor function that creates a new repository instance
 // NewProductRecordRepository - Función constructora que crea una nueva instancia del repositorio
 func NewProductRecordRepository(db *sql.DB) IProductRecordRepository {
-	if productRecordInstance != nil {
-		return productRecordInstance
-	}
-
-	productRecordInstance = &productRecordRepository{DB: db}
-	return productRecordInstance
+	return &productRecordRepository{DB: db}
 }
 
 // productRecordRepository - Repository implementation for product records operations
@@ -37,19 +37,12 @@ const (
 	queryExistProductCode = "SELECT 1 FROM products WHERE product_code = ? LIMIT 1"
 )
 
-var productRepositoryInstance ProductRepository
-
 // NewProductRepository crea una nueva instancia del repositorio de productos
 // NewProductRepository creates a new instance of the product repository
 func NewProductRepository(db *sql.DB) ProductRepository {
-	if productRepositoryInstance != nil {
-		return productRepositoryInstance
-	}
-
-	productRepositoryInstance = &service{
+	return &service{
 		db: db,
 	}
-	return productRepositoryInstance
 }
 
 // ProductRepository define la interfaz para operaciones de productos en la base de datos
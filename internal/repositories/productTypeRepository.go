@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+// Product type table and field constants / Constantes de tabla y campos de tipo de producto
+const (
+	productTypeTable = "products_types"
+
+	// Field groups for better maintainability / Grupos de campos para mejor mantenibilidad
+	productTypeFields       = "`id`, `description`, `storage_class`, `minimum_temperature`, `maximum_temperature`"
+	productTypeInsertFields = "`description`, `storage_class`, `minimum_temperature`, `maximum_temperature`"
+	productTypeUpdateFields = "`description` = ?, `storage_class` = ?, `minimum_temperature` = ?, `maximum_temperature` = ?"
+)
+
+// Product type query strings - organized by operation type / Cadenas de consulta de tipo de producto - organizadas por tipo de operación
+var (
+	// SELECT queries / Consultas SELECT
+	queryGetAllProductTypes         = fmt.Sprintf("SELECT %s FROM `%s` ORDER BY `id`", productTypeFields, productTypeTable)
+	queryGetProductTypeById         = fmt.Sprintf("SELECT %s FROM `%s` WHERE `id` = ?", productTypeFields, productTypeTable)
+	queryExistsProductTypeByDesc    = fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM `%s` WHERE `description` = ? AND `id` <> ?)", productTypeTable)
+	queryCountProductTypeDependents = "SELECT (SELECT COUNT(*) FROM `sections` WHERE `product_type_id` = ?) + (SELECT COUNT(*) FROM `products` WHERE `product_type_id` = ?)"
+
+	// INSERT queries / Consultas INSERT
+	queryCreateProductType = fmt.Sprintf("INSERT INTO `%s`(%s) VALUES (?,?,?,?)", productTypeTable, productTypeInsertFields)
+
+	// UPDATE queries / Consultas UPDATE
+	queryUpdateProductType = fmt.Sprintf("UPDATE `%s` SET %s WHERE `id` = ?", productTypeTable, productTypeUpdateFields)
+
+	// DELETE queries / Consultas DELETE
+	queryDeleteProductType = fmt.Sprintf("DELETE FROM `%s` WHERE `id` = ?", productTypeTable)
+)
+
+// NewProductTypeRepository - Creates and returns a new instance of ProductTypeRepositoryImpl
+// NewProductTypeRepository - Crea y retorna una nueva instancia de ProductTypeRepositoryImpl
+func NewProductTypeRepository(db *sql.DB) ProductTypeRepository {
+	return &ProductTypeRepositoryImpl{db: db}
+}
+
+// ProductTypeRepository - Interface defining the contract for product type repository operations
+// ProductTypeRepository - Interfaz que define el contrato para las operaciones del repositorio de tipos de producto
+type ProductTypeRepository interface {
+	// GetAll - Retrieves all product types ordered by ID
+	// GetAll - Obtiene todos los tipos de producto ordenados por ID
+	GetAll(ctx context.Context) ([]models.ProductType, error)
+
+	// GetById - Retrieves a product type by its ID, returning ErrNotFound if it doesn't exist
+	// GetById - Obtiene un tipo de producto por su ID, retornando ErrNotFound si no existe
+	GetById(ctx context.Context, id int) (models.ProductType, error)
+
+	// Create - Inserts a new product type and returns it with its generated ID
+	// Create - Inserta un nuevo tipo de producto y lo retorna con su ID generado
+	Create(ctx context.Context, productType models.ProductType) (models.ProductType, error)
+
+	// Update - Overwrites every field of an existing product type
+	// Update - Sobrescribe todos los campos de un tipo de producto existente
+	Update(ctx context.Context, id int, productType models.ProductType) (models.ProductType, error)
+
+	// Delete - Removes a product type by its ID, returning ErrNotFound if it doesn't exist
+	// Delete - Elimina un tipo de producto por su ID, retornando ErrNotFound si no existe
+	Delete(ctx context.Context, id int) error
+
+	// ExistsByDescription - Checks if another product type (different from excludeId) uses the description
+	// ExistsByDescription - Verifica si otro tipo de producto (distinto de excludeId) usa la descripción
+	ExistsByDescription(ctx context.Context, description string, excludeId int) (bool, error)
+
+	// CountDependents - Counts the sections and products referencing the product type
+	// CountDependents - Cuenta las secciones y productos que referencian el tipo de producto
+	CountDependents(ctx context.Context, id int) (int, error)
+}
+
+// ProductTypeRepositoryImpl - Implementation of the ProductTypeRepository interface
+// ProductTypeRepositoryImpl - Implementación de la interfaz ProductTypeRepository
+type ProductTypeRepositoryImpl struct {
+	db *sql.DB // Database connection / Conexión a la base de datos
+}
+
+// GetAll - Retrieves all product types from the database
+// GetAll - Obtiene todos los tipos de producto de la base de datos
+func (r *ProductTypeRepositoryImpl) GetAll(ctx context.Context) ([]models.ProductType, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetAllProductTypes)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	productTypes := []models.ProductType{}
+	for rows.Next() {
+		var p models.ProductType
+		if err := rows.Scan(&p.Id, &p.Description, &p.StorageClass, &p.MinimumTemperature, &p.MaximumTemperature); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+		productTypes = append(productTypes, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return productTypes, nil
+}
+
+// GetById - Retrieves a single product type using its primary key
+// GetById - Obtiene un único tipo de producto usando su clave primaria
+func (r *ProductTypeRepositoryImpl) GetById(ctx context.Context, id int) (models.ProductType, error) {
+	var p models.ProductType
+	err := r.db.QueryRowContext(ctx, queryGetProductTypeById, id).
+		Scan(&p.Id, &p.Description, &p.StorageClass, &p.MinimumTemperature, &p.MaximumTemperature)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductType{}, fmt.Errorf("%w: product type with id %d", error_message.ErrNotFound, id)
+		}
+		return models.ProductType{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return p, nil
+}
+
+// Create - Inserts a new product type into the database
+// Create - Inserta un nuevo tipo de producto en la base de datos
+func (r *ProductTypeRepositoryImpl) Create(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	result, err := r.db.ExecContext(ctx, queryCreateProductType,
+		productType.Description, productType.StorageClass, productType.MinimumTemperature, productType.MaximumTemperature)
+	if err != nil {
+		return models.ProductType{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+
+	// Get the auto-generated ID and assign it to the product type / Obtener el ID autogenerado y asignarlo al tipo de producto
+	lastInsertId, err := result.LastInsertId()
+	if err != nil {
+		return models.ProductType{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	productType.Id = int(lastInsertId)
+	return productType, nil
+}
+
+// Update - Overwrites the stored product type with the given values
+// Update - Sobrescribe el tipo de producto almacenado con los valores dados
+func (r *ProductTypeRepositoryImpl) Update(ctx context.Context, id int, productType models.ProductType) (models.ProductType, error) {
+	_, err := r.db.ExecContext(ctx, queryUpdateProductType,
+		productType.Description, productType.StorageClass, productType.MinimumTemperature, productType.MaximumTemperature, id)
+	if err != nil {
+		return models.ProductType{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+
+	productType.Id = id
+	return productType, nil
+}
+
+// Delete - Removes a product type from the database by its ID
+// Delete - Elimina un tipo de producto de la base de datos por su ID
+func (r *ProductTypeRepositoryImpl) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, queryDeleteProductType, id)
+	if err != nil {
+		return dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+
+	// If no rows affected, product type doesn't exist / Si ninguna fila fue afectada, el tipo de producto no existe
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: product type with id %d", error_message.ErrNotFound, id)
+	}
+	return nil
+}
+
+// ExistsByDescription - Checks if the description is already used by another product type
+// ExistsByDescription - Verifica si la descripción ya es usada por otro tipo de producto
+func (r *ProductTypeRepositoryImpl) ExistsByDescription(ctx context.Context, description string, excludeId int) (bool, error) {
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, queryExistsProductTypeByDesc, description, excludeId).Scan(&exists); err != nil {
+		return false, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return exists, nil
+}
+
+// CountDependents - Counts the sections and products that would be removed in cascade
+// CountDependents - Cuenta las secciones y productos que se eliminarían en cascada
+func (r *ProductTypeRepositoryImpl) CountDependents(ctx context.Context, id int) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, queryCountProductTypeDependents, id, id).Scan(&count); err != nil {
+		return 0, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return count, nil
+}
@@ -10,19 +10,12 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
-var purchaseOrderRepositoryInstance PurchaseOrderRepositoryI
-
-// GetNewPurchaseOrderMySQLRepository - Creates and returns a new instance of MySqlPurchaseOrderRepository using singleton pattern
-// GetNewPurchaseOrderMySQLRepository - Crea y retorna una nueva instancia de MySqlPurchaseOrderRepository usando patrón singleton
+// GetNewPurchaseOrderMySQLRepository - Creates and returns a new instance of MySqlPurchaseOrderRepository
+// GetNewPurchaseOrderMySQLRepository - Crea y retorna una nueva instancia de MySqlPurchaseOrderRepository
 func GetNewPurchaseOrderMySQLRepository(db *sql.DB) PurchaseOrderRepositoryI {
-	if purchaseOrderRepositoryInstance != nil {
-		return purchaseOrderRepositoryInstance
-	}
-
-	purchaseOrderRepositoryInstance = &MySqlPurchaseOrderRepository{
+	return &MySqlPurchaseOrderRepository{
 		db: db,
 	}
-	return purchaseOrderRepositoryInstance
 }
 
 // PurchaseOrderRepositoryI - Interface defining the contract for purchase order repository operations
@@ -65,7 +58,7 @@ func (r *MySqlPurchaseOrderRepository) GetAll(ctx context.Context) (map[int]mode
 
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return orders, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return orders, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -77,7 +70,7 @@ func (r *MySqlPurchaseOrderRepository) GetAll(ctx context.Context) (map[int]mode
 		order := models.PurchaseOrder{}
 		err := rows.Scan(&order.Id, &order.OrderNumber, &order.OrderDate, &order.TrackingCode, &order.BuyerId, &order.ProductRecordId)
 		if err != nil {
-			return orders, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return orders, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 
 		tempOrdersMap[order.Id] = order
@@ -96,13 +89,13 @@ func (r *MySqlPurchaseOrderRepository) Create(ctx context.Context, order models.
 
 	result, err := r.db.ExecContext(ctx, query, order.OrderNumber, order.OrderDate, order.TrackingCode, order.BuyerId, order.ProductRecordId)
 	if err != nil {
-		return models.PurchaseOrder{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.PurchaseOrder{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Get the auto-generated ID from the database / Obtiene el ID autogenerado de la base de datos
 	lastId, err := result.LastInsertId()
 	if err != nil {
-		return models.PurchaseOrder{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.PurchaseOrder{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	order.Id = int(lastId)
@@ -128,7 +121,7 @@ group by b.id`
 		if errors.Is(err, sql.ErrNoRows) {
 			return models.PurchaseOrderReport{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Buyer with Id", buyerId, "doesn't exists.")
 		}
-		return models.PurchaseOrderReport{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.PurchaseOrderReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	return report, nil
@@ -148,7 +141,7 @@ order by b.id`
 
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
-		return []models.PurchaseOrderReport{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return []models.PurchaseOrderReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -157,7 +150,7 @@ order by b.id`
 		report := models.PurchaseOrderReport{}
 		err := rows.Scan(&report.Id, &report.IdCardNumber, &report.FirstName, &report.LastName, &report.PurchaseOrderCount)
 		if err != nil {
-			return []models.PurchaseOrderReport{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+			return []models.PurchaseOrderReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 
 		reports = append(reports, report)
@@ -9,21 +9,13 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/pkg/database"
 )
 
-var sectionRepositoryInstance SectionRepositoryI
-
-// GetSectionRepository - Creates and returns a new instance of sectionRepository using singleton pattern
-// GetSectionRepository - Crea y retorna una nueva instancia de sectionRepository usando patrón singleton
+// GetSectionRepository - Creates and returns a new instance of sectionRepository
+// GetSectionRepository - Crea y retorna una nueva instancia de sectionRepository
 func GetSectionRepository(db *sql.DB) SectionRepositoryI {
-	if sectionRepositoryInstance != nil {
-		return sectionRepositoryInstance
-	}
-
-	sectionRepositoryInstance = &sectionRepository{
+	return &sectionRepository{
 		database:  db,
 		tablename: "sections",
 	}
-
-	return sectionRepositoryInstance
 }
 
 // SectionRepositoryI - Interface defining the contract for section repository operations
@@ -56,6 +48,10 @@ type SectionRepositoryI interface {
 	// DeleteByID - Removes a section from the database by its ID
 	// DeleteByID - Elimina una sección de la base de datos por su ID
 	DeleteByID(ctx context.Context, id int) error
+
+	// CountDependents - Counts the rows that would be removed in cascade when deleting the section
+	// CountDependents - Cuenta las filas que se eliminarían en cascada al borrar la sección
+	CountDependents(ctx context.Context, id int) (models.DeleteImpact, error)
 }
 
 // sectionRepository - Implementation of SectionRepositoryI using a generic database helper
@@ -69,7 +65,7 @@ type sectionRepository struct {
 // GetAll - Obtiene todas las secciones de la base de datos y las retorna como un slice de punteros
 func (r *sectionRepository) GetAll(ctx context.Context) ([]*models.Section, error) {
 	// Define columns to select from the sections table / Definir columnas a seleccionar de la tabla de secciones
-	columns := []string{"Id", "section_number", "current_capacity", "current_temperature", "maximum_capacity", "minimum_capacity", "minimum_temperature", "product_type_id", "warehouse_id"}
+	columns := []string{"Id", "section_number", "current_capacity", "current_temperature", "maximum_capacity", "minimum_capacity", "minimum_temperature", "maximum_volume", "maximum_weight", "product_type_id", "warehouse_id"}
 
 	// Execute select query using generic database helper / Ejecutar consulta select usando helper genérico de base de datos
 	rows, err := database.Select(ctx, r.database, r.tablename, columns, "")
@@ -94,6 +90,8 @@ func (r *sectionRepository) GetAll(ctx context.Context) ([]*models.Section, erro
 			&section.MaximumCapacity,
 			&section.MinimumCapacity,
 			&section.MinimumTemperature,
+			&section.MaximumVolume,
+			&section.MaximumWeight,
 			&section.ProductTypeID,
 			&section.WarehouseID,
 		); err != nil {
@@ -111,7 +109,7 @@ func (r *sectionRepository) GetAll(ctx context.Context) ([]*models.Section, erro
 // GetByID - Obtiene una sección específica por su ID de la base de datos
 func (r *sectionRepository) GetByID(ctx context.Context, id int) (*models.Section, error) {
 	// Define columns to select from the sections table / Definir columnas a seleccionar de la tabla de secciones
-	columns := []string{"Id", "section_number", "current_capacity", "current_temperature", "maximum_capacity", "minimum_capacity", "minimum_temperature", "product_type_id", "warehouse_id"}
+	columns := []string{"Id", "section_number", "current_capacity", "current_temperature", "maximum_capacity", "minimum_capacity", "minimum_temperature", "maximum_volume", "maximum_weight", "product_type_id", "warehouse_id"}
 	// Execute select query for specific ID using generic database helper / Ejecutar consulta select para ID específico usando helper genérico de base de datos
 	row := database.SelectOne(ctx, r.database, r.tablename, columns, "Id = ?", id)
 
@@ -126,6 +124,8 @@ func (r *sectionRepository) GetByID(ctx context.Context, id int) (*models.Sectio
 		&section.MaximumCapacity,
 		&section.MinimumCapacity,
 		&section.MinimumTemperature,
+		&section.MaximumVolume,
+		&section.MaximumWeight,
 		&section.ProductTypeID,
 		&section.WarehouseID,
 	); err != nil {
@@ -146,6 +146,8 @@ func (r *sectionRepository) Create(ctx context.Context, model *models.Section) e
 	data["maximum_capacity"] = model.MaximumCapacity
 	data["minimum_capacity"] = model.MinimumCapacity
 	data["minimum_temperature"] = model.MinimumTemperature
+	data["maximum_volume"] = model.MaximumVolume
+	data["maximum_weight"] = model.MaximumWeight
 	data["product_type_id"] = model.ProductTypeID
 	data["warehouse_id"] = model.WarehouseID
 
@@ -170,7 +172,7 @@ func (r *sectionRepository) Create(ctx context.Context, model *models.Section) e
 // Update - Modifica una sección existente en la base de datos con los datos proporcionados usando una declaración SQL directa
 func (r *sectionRepository) Update(ctx context.Context, model *models.Section) error {
 	// Build SQL update statement with all section fields / Construir declaración SQL de actualización con todos los campos de sección
-	sqlStatement := fmt.Sprintf("UPDATE %s SET `section_number`=?, `current_capacity`=?, `current_temperature`=?, `maximum_capacity`=?, `minimum_capacity`=?, `minimum_temperature`=?, `product_type_id`=?, `warehouse_id`=? WHERE `Id`=?", r.tablename)
+	sqlStatement := fmt.Sprintf("UPDATE %s SET `section_number`=?, `current_capacity`=?, `current_temperature`=?, `maximum_capacity`=?, `minimum_capacity`=?, `minimum_temperature`=?, `maximum_volume`=?, `maximum_weight`=?, `product_type_id`=?, `warehouse_id`=? WHERE `Id`=?", r.tablename)
 	// Execute update statement with section data / Ejecutar declaración de actualización con datos de sección
 	_, err := r.database.Exec(sqlStatement,
 		model.SectionNumber,
@@ -179,6 +181,8 @@ func (r *sectionRepository) Update(ctx context.Context, model *models.Section) e
 		model.MaximumCapacity,
 		model.MinimumCapacity,
 		model.MinimumTemperature,
+		model.MaximumVolume,
+		model.MaximumWeight,
 		model.ProductTypeID,
 		model.WarehouseID,
 		model.Id,
@@ -227,3 +231,24 @@ func (r *sectionRepository) DeleteByID(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// CountDependents - Counts product batches and inbound orders linked to a section using the generic database helper
+// CountDependents - Cuenta lotes de productos y órdenes de entrada vinculadas a una sección usando el helper genérico de base de datos
+func (r *sectionRepository) CountDependents(ctx context.Context, id int) (models.DeleteImpact, error) {
+	var impact models.DeleteImpact
+
+	// Count product batches stored in the section / Contar lotes de productos almacenados en la sección
+	row := database.SelectOne(ctx, r.database, "product_batches", []string{"COUNT(*)"}, "section_id = ?", id)
+	if err := row.Scan(&impact.ProductBatches); err != nil {
+		return models.DeleteImpact{}, err
+	}
+
+	// Count inbound orders referencing those batches / Contar órdenes de entrada que referencian esos lotes
+	row = database.SelectOne(ctx, r.database, "inbound_orders", []string{"COUNT(*)"},
+		"product_batch_id IN (SELECT id FROM product_batches WHERE section_id = ?)", id)
+	if err := row.Scan(&impact.InboundOrders); err != nil {
+		return models.DeleteImpact{}, err
+	}
+
+	return impact, nil
+}
@@ -1,23 +1,65 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 )
 
-var sellerRepositoryInstance SellerRepository
+// Seller table and field constants / Constantes de tabla y campos de vendedor
+const (
+	sellerTable = "sellers"
 
-// NewSQLSellerRepository - Creates and returns a new instance of SQLSellerRepository using singleton pattern
-// NewSQLSellerRepository - Crea y retorna una nueva instancia de SQLSellerRepository usando patrón singleton
-func NewSQLSellerRepository(db *sql.DB) SellerRepository {
-	if sellerRepositoryInstance != nil {
-		return sellerRepositoryInstance
-	}
+	// Field groups for better maintainability / Grupos de campos para mejor mantenibilidad
+	sellerFields       = "`id`, `cid`, `company_name`, `address`, `telephone`, `locality_id`"
+	sellerInsertFields = "`cid`, `company_name`, `address`, `telephone`, `locality_id`"
+)
+
+// Seller query strings - organized by operation type / Cadenas de consulta de vendedor - organizadas por tipo de operación
+var (
+	// SELECT queries / Consultas SELECT
+	queryGetAllSellers     = fmt.Sprintf("SELECT %s FROM `%s` ORDER BY `id`", sellerFields, sellerTable)
+	queryGetSellerById     = fmt.Sprintf("SELECT %s FROM `%s` WHERE `id` = ?", sellerFields, sellerTable)
+	queryExistsSellerByCid = fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM `%s` WHERE `cid` = ?)", sellerTable)
 
-	sellerRepositoryInstance = &SQLSellerRepository{db: db}
-	return sellerRepositoryInstance
+	// INSERT queries / Consultas INSERT
+	queryCreateSeller = fmt.Sprintf("INSERT INTO `%s`(%s) VALUES (?,?,?,?,?)", sellerTable, sellerInsertFields)
+
+	// UPDATE queries / Consultas UPDATE
+	queryUpdateSeller = fmt.Sprintf("UPDATE `%s` SET `cid` = ?, `company_name` = ?, `address` = ?, `telephone` = ?, `locality_id` = ? WHERE `id` = ?", sellerTable)
+
+	// DELETE queries / Consultas DELETE
+	queryDeleteSeller = fmt.Sprintf("DELETE FROM `%s` WHERE `id` = ?", sellerTable)
+
+	// Catalog and report queries / Consultas de catálogo y reportes
+	queryGetSellerProducts = "SELECT " + productColumns + " FROM products WHERE seller_id = ? ORDER BY id"
+	queryGetSellerReport   = `
+		SELECT s.id, s.company_name,
+			(SELECT COUNT(*) FROM products p WHERE p.seller_id = s.id),
+			(SELECT COALESCE(SUM(pb.current_quantity), 0) FROM product_batches pb
+				INNER JOIN products p ON p.id = pb.product_id
+				WHERE p.seller_id = s.id),
+			(SELECT COUNT(po.id) FROM purchase_orders po
+				INNER JOIN product_records pr ON pr.id = po.product_record_id
+				INNER JOIN products p ON p.id = pr.product_id
+				WHERE p.seller_id = s.id AND (? IS NULL OR po.order_date >= ?) AND (? IS NULL OR po.order_date < ?)),
+			(SELECT COALESCE(SUM(pr.sale_price), 0) FROM purchase_orders po
+				INNER JOIN product_records pr ON pr.id = po.product_record_id
+				INNER JOIN products p ON p.id = pr.product_id
+				WHERE p.seller_id = s.id AND (? IS NULL OR po.order_date >= ?) AND (? IS NULL OR po.order_date < ?))
+		FROM sellers s
+		WHERE s.id = ?`
+)
+
+// NewSQLSellerRepository - Creates and returns a new instance of SQLSellerRepository
+// NewSQLSellerRepository - Crea y retorna una nueva instancia de SQLSellerRepository
+func NewSQLSellerRepository(db *sql.DB) SellerRepository {
+	return &SQLSellerRepository{db: db}
 }
 
 // SellerRepository - Interface defining the contract for seller repository operations
@@ -25,19 +67,35 @@ func NewSQLSellerRepository(db *sql.DB) SellerRepository {
 type SellerRepository interface {
 	// GetAll - Retrieves all sellers from the database
 	// GetAll - Obtiene todos los vendedores de la base de datos
-	GetAll() ([]models.Seller, error)
+	GetAll(ctx context.Context) ([]models.Seller, error)
 
-	// Save - Creates a new seller in the database with validation checks for CID uniqueness and locality existence
-	// Save - Crea un nuevo vendedor en la base de datos con validaciones de unicidad de CID y existencia de localidad
-	Save(seller models.Seller) ([]models.Seller, error)
+	// GetById - Retrieves a seller by its ID, returning ErrNotFound if it doesn't exist
+	// GetById - Obtiene un vendedor por su ID, retornando ErrNotFound si no existe
+	GetById(ctx context.Context, id int) (models.Seller, error)
 
-	// Update - Modifies an existing seller in the database with partial update support
-	// Update - Modifica un vendedor existente en la base de datos con soporte para actualizaciones parciales
-	Update(id int, seller models.Seller) ([]models.Seller, error)
+	// Save - Inserts a new seller and returns it with its generated ID
+	// Save - Inserta un nuevo vendedor y lo retorna con su ID generado
+	Save(ctx context.Context, seller models.Seller) (models.Seller, error)
+
+	// Update - Overwrites every field of an existing seller
+	// Update - Sobrescribe todos los campos de un vendedor existente
+	Update(ctx context.Context, id int, seller models.Seller) (models.Seller, error)
 
 	// Delete - Removes a seller from the database by their ID
 	// Delete - Elimina un vendedor de la base de datos por su ID
-	Delete(id int) error
+	Delete(ctx context.Context, id int) error
+
+	// ExistsByCid - Checks if a seller with the given CID already exists
+	// ExistsByCid - Verifica si ya existe un vendedor con el CID dado
+	ExistsByCid(ctx context.Context, cid string) (bool, error)
+
+	// GetProducts - Retrieves the products linked to a seller through products.seller_id
+	// GetProducts - Obtiene los productos vinculados a un vendedor mediante products.seller_id
+	GetProducts(ctx context.Context, sellerId int) ([]models.Product, error)
+
+	// GetReport - Computes the seller report, returning ErrNotFound if the seller doesn't exist
+	// GetReport - Calcula el reporte del vendedor, retornando ErrNotFound si el vendedor no existe
+	GetReport(ctx context.Context, sellerId int, from, to *time.Time) (models.SellerReport, error)
 }
 
 // SQLSellerRepository - SQL implementation of the SellerRepository interface
@@ -48,11 +106,10 @@ type SQLSellerRepository struct {
 
 // GetAll - Retrieves all sellers from the database and returns them as a slice
 // GetAll - Obtiene todos los vendedores de la base de datos y los retorna como un slice
-func (r *SQLSellerRepository) GetAll() ([]models.Seller, error) {
-	// Execute query to select all seller fields / Ejecutar consulta para seleccionar todos los campos del vendedor
-	rows, err := r.db.Query("SELECT id, cid, company_name, address, telephone, locality_id FROM sellers")
+func (r *SQLSellerRepository) GetAll(ctx context.Context) ([]models.Seller, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetAllSellers)
 	if err != nil {
-		return nil, err
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	defer rows.Close()
 
@@ -62,114 +119,145 @@ func (r *SQLSellerRepository) GetAll() ([]models.Seller, error) {
 	for rows.Next() {
 		var s models.Seller
 		if err := rows.Scan(&s.Id, &s.CID, &s.CompanyName, &s.Address, &s.Telephone, &s.LocalityID); err != nil {
-			return nil, err
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 		sellers = append(sellers, s)
 	}
-	return sellers, nil
-}
 
-// Save - Creates a new seller in the database with validation for CID uniqueness and locality existence
-// Save - Crea un nuevo vendedor en la base de datos con validación de unicidad de CID y existencia de localidad
-func (r *SQLSellerRepository) Save(seller models.Seller) ([]models.Seller, error) {
-	// Validate that no other seller exists with the same CID / Validar que no exista otro vendedor con el mismo CID
-	var exists bool
-	err := r.db.QueryRow("SELECT EXISTS(SELECT 1 FROM sellers WHERE cid = ?)", seller.CID).Scan(&exists)
-	if err != nil {
-		return nil, err
-	}
-	if exists {
-		return nil, error_message.ErrAlreadyExists
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
+	return sellers, nil
+}
 
-	// Validate that the locality exists before creating the seller / Validar que la localidad existe antes de crear el vendedor
-	var existsLocality bool
-	err = r.db.QueryRow("SELECT EXISTS(SELECT 1 FROM localities WHERE id = ?)", seller.LocalityID).Scan(&existsLocality)
+// GetById - Retrieves a single seller using its primary key
+// GetById - Obtiene un único vendedor usando su clave primaria
+func (r *SQLSellerRepository) GetById(ctx context.Context, id int) (models.Seller, error) {
+	var s models.Seller
+	err := r.db.QueryRowContext(ctx, queryGetSellerById, id).
+		Scan(&s.Id, &s.CID, &s.CompanyName, &s.Address, &s.Telephone, &s.LocalityID)
 	if err != nil {
-		return nil, err
-	}
-	if !existsLocality {
-		return nil, error_message.ErrDependencyNotFound
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Seller{}, fmt.Errorf("%w: seller with id %d", error_message.ErrNotFound, id)
+		}
+		return models.Seller{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
+	return s, nil
+}
 
+// Save - Inserts a new seller into the database
+// Save - Inserta un nuevo vendedor en la base de datos
+func (r *SQLSellerRepository) Save(ctx context.Context, seller models.Seller) (models.Seller, error) {
 	// Execute insert statement with seller data / Ejecutar declaración de inserción con datos del vendedor
-	res, err := r.db.Exec("INSERT INTO sellers (cid, company_name, address, telephone, locality_id) VALUES (?, ?, ?, ?, ?)",
+	res, err := r.db.ExecContext(ctx, queryCreateSeller,
 		seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.LocalityID)
 	if err != nil {
-		return nil, err
+		return models.Seller{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Get the auto-generated ID and assign it to the seller / Obtener el ID autogenerado y asignarlo al vendedor
 	lastID, err := res.LastInsertId()
 	if err != nil {
-		return nil, err
+		return models.Seller{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	seller.Id = int(lastID)
-	return []models.Seller{seller}, nil
+	return seller, nil
 }
 
-// Update - Modifies an existing seller in the database with support for partial updates
-// Update - Modifica un vendedor existente en la base de datos con soporte para actualizaciones parciales
-func (r *SQLSellerRepository) Update(id int, seller models.Seller) ([]models.Seller, error) {
-	// Retrieve existing seller data to perform partial update / Obtener datos del vendedor existente para realizar actualización parcial
-	var existing models.Seller
-	err := r.db.QueryRow("SELECT id, cid, company_name, address, telephone, locality_id FROM sellers WHERE id = ?", id).
-		Scan(&existing.Id, &existing.CID, &existing.CompanyName, &existing.Address, &existing.Telephone, &existing.LocalityID)
-
-	// Handle case when seller is not found / Manejar el caso cuando el vendedor no se encuentra
-	if err == sql.ErrNoRows {
-		return nil, error_message.ErrNotFound
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	// Update only the provided fields (partial update logic) / Actualizar solo los campos proporcionados (lógica de actualización parcial)
-	if seller.CID != "" {
-		existing.CID = seller.CID
-	}
-	if seller.CompanyName != "" {
-		existing.CompanyName = seller.CompanyName
-	}
-	if seller.Address != "" {
-		existing.Address = seller.Address
-	}
-	if seller.Telephone != "" {
-		existing.Telephone = seller.Telephone
-	}
-
-	if seller.LocalityID != 0 {
-		existing.LocalityID = seller.LocalityID
-	}
-
-	// Execute update statement with merged data / Ejecutar declaración de actualización con datos combinados
-	_, err = r.db.Exec("UPDATE sellers SET cid = ?, company_name = ?, address = ?, telephone = ?, locality_id = ? WHERE id = ?",
-		existing.CID, existing.CompanyName, existing.Address, existing.Telephone, existing.LocalityID, id)
-
+// Update - Overwrites the stored seller with the given values
+// Update - Sobrescribe el vendedor almacenado con los valores dados
+func (r *SQLSellerRepository) Update(ctx context.Context, id int, seller models.Seller) (models.Seller, error) {
+	_, err := r.db.ExecContext(ctx, queryUpdateSeller,
+		seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.LocalityID, id)
 	if err != nil {
-		return nil, err
+		return models.Seller{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
-	return []models.Seller{existing}, nil
+	seller.Id = id
+	return seller, nil
 }
 
 // Delete - Removes a seller from the database by their ID
 // Delete - Elimina un vendedor de la base de datos por su ID
-func (r *SQLSellerRepository) Delete(id int) error {
+func (r *SQLSellerRepository) Delete(ctx context.Context, id int) error {
 	// Execute delete statement for the specified seller ID / Ejecutar declaración de eliminación para el ID del vendedor especificado
-	res, err := r.db.Exec("DELETE FROM sellers WHERE id = ?", id)
+	res, err := r.db.ExecContext(ctx, queryDeleteSeller, id)
 	if err != nil {
-		return err
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Check if any rows were affected to confirm deletion / Verificar si alguna fila fue afectada para confirmar la eliminación
 	count, err := res.RowsAffected()
 	if err != nil {
-		return err
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	// If no rows affected, seller doesn't exist / Si ninguna fila fue afectada, el vendedor no existe
 	if count == 0 {
-		return error_message.ErrNotFound
+		return fmt.Errorf("%w: seller with id %d", error_message.ErrNotFound, id)
 	}
 	return nil
 }
+
+// ExistsByCid - Checks if a seller with the given CID already exists in the database
+// ExistsByCid - Verifica si un vendedor con el CID dado ya existe en la base de datos
+func (r *SQLSellerRepository) ExistsByCid(ctx context.Context, cid string) (bool, error) {
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, queryExistsSellerByCid, cid).Scan(&exists); err != nil {
+		return false, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return exists, nil
+}
+
+// GetProducts - Retrieves the catalog of a seller ordered by product ID
+// GetProducts - Obtiene el catálogo de un vendedor ordenado por ID de producto
+func (r *SQLSellerRepository) GetProducts(ctx context.Context, sellerId int) ([]models.Product, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetSellerProducts, sellerId)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	products := []models.Product{}
+	for rows.Next() {
+		var p models.Product
+		if err := rows.Scan(&p.Id, &p.Description, &p.ExpirationRate, &p.FreezingRate, &p.Height,
+			&p.Length, &p.NetWeight, &p.ProductCode, &p.RecommendedFreezingTemperature,
+			&p.Width, &p.ProductTypeID, &p.SellerID); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+		products = append(products, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return products, nil
+}
+
+// GetReport - Aggregates catalog size, batch stock and sales of a seller in a single query
+// GetReport - Agrega tamaño del catálogo, stock en lotes y ventas de un vendedor en una sola consulta
+func (r *SQLSellerRepository) GetReport(ctx context.Context, sellerId int, from, to *time.Time) (models.SellerReport, error) {
+	// The upper bound is the day after To so the whole last day is included
+	// El límite superior es el día siguiente a To para incluir todo el último día
+	var lower, upper sql.NullTime
+	if from != nil {
+		lower = sql.NullTime{Time: *from, Valid: true}
+	}
+	if to != nil {
+		upper = sql.NullTime{Time: to.AddDate(0, 0, 1), Valid: true}
+	}
+
+	report := models.SellerReport{From: from, To: to}
+	err := r.db.QueryRowContext(ctx, queryGetSellerReport,
+		lower, lower, upper, upper,
+		lower, lower, upper, upper,
+		sellerId,
+	).Scan(&report.SellerId, &report.CompanyName, &report.ProductsCount, &report.BatchStock, &report.UnitsSold, &report.Revenue)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.SellerReport{}, fmt.Errorf("%w: seller with id %d", error_message.ErrNotFound, sellerId)
+		}
+		return models.SellerReport{}, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	return report, nil
+}
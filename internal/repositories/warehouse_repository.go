@@ -26,6 +26,36 @@ var (
 	queryGetWarehouseById = fmt.Sprintf("SELECT %s FROM `%s` WHERE `id` = ?", warehouseFields, warehouseTable)
 	queryExistsByCode     = fmt.Sprintf("SELECT COUNT(*) FROM `%s` WHERE `warehouse_code` = ?", warehouseTable)
 
+	// Dependent count queries used by delete impact preview / Consultas de conteo de dependientes usadas por la vista previa de borrado
+	queryCountWarehouseSections       = "SELECT COUNT(*) FROM `sections` WHERE `warehouse_id` = ?"
+	queryCountWarehouseProductBatches = "SELECT COUNT(*) FROM `product_batches` pb INNER JOIN `sections` s ON s.`id` = pb.`section_id` WHERE s.`warehouse_id` = ?"
+	queryCountWarehouseEmployees      = "SELECT COUNT(*) FROM `employees` WHERE `warehouse_id` = ?"
+	queryCountWarehouseInboundOrders  = "SELECT COUNT(*) FROM `inbound_orders` io " +
+		"WHERE io.`warehouse_id` = ? " +
+		"OR io.`employee_id` IN (SELECT e.`id` FROM `employees` e WHERE e.`warehouse_id` = ?) " +
+		"OR io.`product_batch_id` IN (SELECT pb.`id` FROM `product_batches` pb INNER JOIN `sections` s ON s.`id` = pb.`section_id` WHERE s.`warehouse_id` = ?)"
+
+	// Stock query, one row per section and product; sections without batches come once with NULL product
+	// Consulta de stock, una fila por sección y producto; las secciones sin lotes vienen una vez con producto NULL
+	queryGetWarehouseStock = "SELECT s.`id`, s.`section_number`, s.`maximum_capacity`, s.`maximum_volume`, s.`maximum_weight`, " +
+		"p.`id`, p.`product_code`, p.`description`, " +
+		"COUNT(pb.`id`), COALESCE(SUM(pb.`current_quantity`), 0), " +
+		"COALESCE(SUM(pb.`current_quantity` * p.`width` * p.`height` * p.`length`), 0), " +
+		"COALESCE(SUM(pb.`current_quantity` * p.`net_weight`), 0), MIN(pb.`due_date`) " +
+		"FROM `sections` s " +
+		"LEFT JOIN `product_batches` pb ON pb.`section_id` = s.`id` " +
+		"LEFT JOIN `products` p ON p.`id` = pb.`product_id` " +
+		"WHERE s.`warehouse_id` = ? " +
+		"GROUP BY s.`id`, s.`section_number`, s.`maximum_capacity`, s.`maximum_volume`, s.`maximum_weight`, p.`id`, p.`product_code`, p.`description` " +
+		"ORDER BY s.`id`, p.`id`"
+
+	// Units per warehouse, warehouses without batches come with 0 / Unidades por almacén, los almacenes sin lotes vienen con 0
+	queryGetWarehouseStockTotals = "SELECT w.`id`, w.`warehouse_code`, COALESCE(SUM(pb.`current_quantity`), 0) " +
+		"FROM `warehouse` w " +
+		"LEFT JOIN `sections` s ON s.`warehouse_id` = w.`id` " +
+		"LEFT JOIN `product_batches` pb ON pb.`section_id` = s.`id` " +
+		"GROUP BY w.`id`, w.`warehouse_code` ORDER BY w.`id`"
+
 	// INSERT queries / Consultas INSERT
 	queryCreateWarehouse = fmt.Sprintf("INSERT INTO `%s`(%s) VALUES (?,?,?,?,?,?)", warehouseTable, warehouseInsertFields)
 
@@ -34,18 +64,12 @@ var (
 
 	// DELETE queries / Consultas DELETE
 	queryDeleteWarehouse = fmt.Sprintf("DELETE FROM `%s` WHERE `id` = ?", warehouseTable)
-
-	warehouseRepositoryInstance WarehouseRepository
 )
 
-// NewWarehouseRepository - Creates and returns a new instance of WarehouseRepositoryImpl using singleton pattern
-// NewWarehouseRepository - Crea y retorna una nueva instancia de WarehouseRepositoryImpl usando patrón singleton
+// NewWarehouseRepository - Creates and returns a new instance of WarehouseRepositoryImpl
+// NewWarehouseRepository - Crea y retorna una nueva instancia de WarehouseRepositoryImpl
 func NewWarehouseRepository(db *sql.DB) WarehouseRepository {
-	if warehouseRepositoryInstance != nil {
-		return warehouseRepositoryInstance
-	}
-	warehouseRepositoryInstance = &WarehouseRepositoryImpl{db: db}
-	return warehouseRepositoryInstance
+	return &WarehouseRepositoryImpl{db: db}
 }
 
 // WarehouseRepository - Interface defining the contract for warehouse repository operations
@@ -74,6 +98,18 @@ type WarehouseRepository interface {
 	// Update - Modifies an existing warehouse in the database and returns the updated warehouse
 	// Update - Modifica un almacén existente en la base de datos y retorna el almacén actualizado
 	Update(ctx context.Context, id int, warehouse models.Warehouse) (models.Warehouse, error)
+
+	// CountDependents - Counts the rows that would be removed in cascade when deleting the warehouse
+	// CountDependents - Cuenta las filas que se eliminarían en cascada al borrar el almacén
+	CountDependents(ctx context.Context, id int) (models.DeleteImpact, error)
+
+	// GetStock - Retrieves the batches of the warehouse grouped by section and product
+	// GetStock - Obtiene los lotes del almacén agrupados por sección y producto
+	GetStock(ctx context.Context, id int) ([]models.WarehouseStockRow, error)
+
+	// GetStockTotals - Retrieves the units stored in every warehouse
+	// GetStockTotals - Obtiene las unidades guardadas en cada almacén
+	GetStockTotals(ctx context.Context) ([]models.WarehouseStockTotal, error)
 }
 
 // WarehouseRepositoryImpl - Implementation of the WarehouseRepository interface
@@ -88,8 +124,7 @@ func (r *WarehouseRepositoryImpl) GetAll(ctx context.Context) ([]models.Warehous
 	// Execute query to select all warehouse fields / Ejecutar consulta para seleccionar todos los campos del almacén
 	rows, err := r.db.QueryContext(ctx, queryGetAllWarehouses)
 	if err != nil {
-		fmt.Printf("warehouse: %v\n", err.Error())
-		return nil, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Iterate through all rows and scan each warehouse into the results slice
@@ -99,7 +134,7 @@ func (r *WarehouseRepositoryImpl) GetAll(ctx context.Context) ([]models.Warehous
 		var w models.Warehouse
 		err := rows.Scan(&w.Id, &w.Address, &w.Telephone, &w.WareHouseCode, &w.MinimumCapacity, &w.MinimumTemperature)
 		if err != nil {
-			return nil, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
 		}
 		warehouses = append(warehouses, w)
 
@@ -115,14 +150,13 @@ func (r *WarehouseRepositoryImpl) Create(ctx context.Context, warehouse models.W
 	result, err := r.db.ExecContext(ctx, queryCreateWarehouse,
 		warehouse.Address, warehouse.Telephone, warehouse.WareHouseCode, warehouse.MinimumCapacity, warehouse.MinimumTemperature, warehouse.LocalityId)
 	if err != nil {
-		fmt.Printf("warehouse: %v\n", err.Error())
-		return models.Warehouse{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Warehouse{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Get the auto-generated ID and assign it to the warehouse / Obtener el ID autogenerado y asignarlo al almacén
 	lastInsertId, err := result.LastInsertId()
 	if err != nil {
-		return models.Warehouse{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Warehouse{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 	warehouse.Id = int(lastInsertId)
 
@@ -138,7 +172,7 @@ func (r *WarehouseRepositoryImpl) ExistsByCode(ctx context.Context, code string)
 	var count int
 	err := row.Scan(&count)
 	if err != nil {
-		return false, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return false, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	return count > 0, nil
@@ -157,7 +191,7 @@ func (r *WarehouseRepositoryImpl) GetById(ctx context.Context, id int) (models.W
 		if err == sql.ErrNoRows {
 			return models.Warehouse{}, fmt.Errorf("%w: warehouse with id %d", error_message.ErrNotFound, id)
 		}
-		return models.Warehouse{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Warehouse{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	return warehouse, nil
@@ -173,8 +207,7 @@ func (r *WarehouseRepositoryImpl) Delete(ctx context.Context, id int) error {
 		if err == sql.ErrNoRows {
 			return fmt.Errorf("%w: warehouse with id %d", error_message.ErrNotFound, id)
 		}
-		fmt.Printf("warehouse: %v\n", err.Error())
-		return fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	return nil
@@ -187,13 +220,13 @@ func (r *WarehouseRepositoryImpl) Update(ctx context.Context, id int, warehouse
 	result, err := r.db.ExecContext(ctx, queryUpdateWarehouse,
 		warehouse.Address, warehouse.Telephone, warehouse.WareHouseCode, warehouse.MinimumCapacity, warehouse.MinimumTemperature, id)
 	if err != nil {
-		return models.Warehouse{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Warehouse{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// Check if any rows were affected to confirm update / Verificar si alguna fila fue afectada para confirmar la actualización
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return models.Warehouse{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Warehouse{}, dbError(ctx, error_message.ErrInternalServerError, err)
 	}
 
 	// If no rows affected, warehouse doesn't exist / Si ninguna fila fue afectada, el almacén no existe
@@ -203,3 +236,88 @@ func (r *WarehouseRepositoryImpl) Update(ctx context.Context, id int, warehouse
 
 	return warehouse, nil
 }
+
+// CountDependents - Counts sections, product batches, employees and inbound orders linked to a warehouse
+// CountDependents - Cuenta secciones, lotes de productos, empleados y órdenes de entrada vinculadas a un almacén
+func (r *WarehouseRepositoryImpl) CountDependents(ctx context.Context, id int) (models.DeleteImpact, error) {
+	var impact models.DeleteImpact
+
+	// Each count is scanned into its matching field / Cada conteo se escanea en su campo correspondiente
+	counts := []struct {
+		query  string
+		args   []any
+		target *int
+	}{
+		{queryCountWarehouseSections, []any{id}, &impact.Sections},
+		{queryCountWarehouseProductBatches, []any{id}, &impact.ProductBatches},
+		{queryCountWarehouseEmployees, []any{id}, &impact.Employees},
+		{queryCountWarehouseInboundOrders, []any{id, id, id}, &impact.InboundOrders},
+	}
+
+	for _, c := range counts {
+		if err := r.db.QueryRowContext(ctx, c.query, c.args...).Scan(c.target); err != nil {
+			return models.DeleteImpact{}, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+	}
+
+	return impact, nil
+}
+
+// GetStock - Retrieves the batches of the warehouse grouped by section and product
+// GetStock - Obtiene los lotes del almacén agrupados por sección y producto
+func (r *WarehouseRepositoryImpl) GetStock(ctx context.Context, id int) ([]models.WarehouseStockRow, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetWarehouseStock, id)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	stock := []models.WarehouseStockRow{}
+	for rows.Next() {
+		var (
+			row         models.WarehouseStockRow
+			productId   sql.NullInt64
+			code, desc  sql.NullString
+			earliestDue sql.NullTime
+		)
+		err := rows.Scan(&row.SectionId, &row.SectionNumber, &row.MaximumCapacity, &row.MaximumVolume, &row.MaximumWeight,
+			&productId, &code, &desc, &row.Batches, &row.Quantity, &row.Volume, &row.Weight, &earliestDue)
+		if err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+
+		// NULL columns mean a section without batches / Columnas NULL indican una sección sin lotes
+		row.ProductId = int(productId.Int64)
+		row.ProductCode = code.String
+		row.ProductDescription = desc.String
+		if earliestDue.Valid {
+			row.EarliestDueDate = &earliestDue.Time
+		}
+		stock = append(stock, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+
+	return stock, nil
+}
+
+// GetStockTotals - Sums the current quantity of the batches of every warehouse
+// GetStockTotals - Suma la cantidad actual de los lotes de cada almacén
+func (r *WarehouseRepositoryImpl) GetStockTotals(ctx context.Context) ([]models.WarehouseStockTotal, error) {
+	rows, err := r.db.QueryContext(ctx, queryGetWarehouseStockTotals)
+	if err != nil {
+		return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+	}
+	defer rows.Close()
+
+	totals := []models.WarehouseStockTotal{}
+	for rows.Next() {
+		var total models.WarehouseStockTotal
+		if err := rows.Scan(&total.WarehouseId, &total.WarehouseCode, &total.Quantity); err != nil {
+			return nil, dbError(ctx, error_message.ErrInternalServerError, err)
+		}
+		totals = append(totals, total)
+	}
+	return totals, rows.Err()
+}
@@ -5,25 +5,60 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/container"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/i18n"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/logging"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/openapi"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-func SetupRoutes(c *container.Container) *chi.Mux {
+// SetupRoutes builds the router; every route group under /api/v1 gets its own deadline from timeouts
+// SetupRoutes arma el router; cada grupo de rutas bajo /api/v1 recibe su propio plazo de timeouts
+func SetupRoutes(c *container.Container, timeouts config.RouteTimeouts) *chi.Mux {
 
 	router := chi.NewRouter()
-
-	router.Use(middleware.Logger)
+	doc := openapi.Build(openapi.Routes)
+
+	router.Use(logging.RequestIDMiddleware)
+	router.Use(tracing.Middleware)
+	router.Use(logging.AccessLog)
+	// Metrics wraps Recoverer like AccessLog, so the 500 of a panic is counted
+	// Metrics envuelve a Recoverer como AccessLog, así se cuenta el 500 de un panic
+	router.Use(c.Metrics.Middleware)
 	router.Use(middleware.Recoverer)
+	router.Use(i18n.Middleware)
 
-	router.Route("/api/v1", func(r chi.Router) {
+	// Orchestrator probes, build info and metrics live outside the versioned API
+	// Las pruebas del orquestador, la información del build y las métricas quedan fuera de la API versionada
+	router.Get("/healthz", c.HealthHandler.Healthz)
+	router.Get("/readyz", c.HealthHandler.Readyz)
+	router.Get("/version", c.HealthHandler.Version)
+	router.Method(http.MethodGet, "/metrics", c.Metrics.Handler())
 
-		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			w.Write([]byte(`{"message": "API v1 is running", "status": "active"}`))
+	router.Route("/api/v1", func(r chi.Router) {
+		// Requests are checked against the published document before reaching the handlers
+		// Las solicitudes se validan contra el documento publicado antes de llegar a los handlers
+		r.Use(openapi.Validator(doc, handlers.WriteRequestError))
+
+		// Deadlines are set per group and not for the whole API, since a child context can only shorten its parent's
+		// Los plazos se definen por grupo y no para toda la API, ya que un contexto hijo solo puede acortar el del padre
+		r.Group(func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Default))
+
+			r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"message": "API v1 is running", "status": "active"}`))
+			})
+
+			r.Get("/openapi.json", openapi.Handler(doc))
+			r.Get("/database/stats", c.DatabaseHandler.Stats)
 		})
 
 		r.Route("/employee", func(rt chi.Router) {
+			rt.Use(handlers.Timeout(timeouts.Employees))
 
 			rt.Get("/", c.EmployeeHandler.GetAllEmployee())
 			rt.Get("/{id}", c.EmployeeHandler.GetByIdEmployee())
@@ -36,6 +71,7 @@ func SetupRoutes(c *container.Container) *chi.Mux {
 		})
 
 		r.Route("/buyers", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Buyers))
 
 			r.Get("/", c.BuyerHandler.GetAll())
 			r.Get("/{id}", c.BuyerHandler.GetById())
@@ -46,24 +82,40 @@ func SetupRoutes(c *container.Container) *chi.Mux {
 		})
 
 		r.Route("/warehouse", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Warehouses))
 
 			r.Get("/{id}", c.WarehouseHandler.GetById)
+			r.Get("/{id}/stock", c.WarehouseHandler.GetStock)
 			r.Get("/", c.WarehouseHandler.GetAll)
 			r.Post("/", c.WarehouseHandler.Create)
 			r.Patch("/{id}", c.WarehouseHandler.Update)
 			r.Delete("/{id}", c.WarehouseHandler.Delete)
 		})
 
+		r.Route("/productTypes", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.ProductTypes))
+
+			r.Get("/", c.ProductTypeHandler.GetAll)
+			r.Get("/{id}", c.ProductTypeHandler.GetById)
+			r.Post("/", c.ProductTypeHandler.Create)
+			r.Patch("/{id}", c.ProductTypeHandler.Update)
+			r.Delete("/{id}", c.ProductTypeHandler.Delete)
+		})
+
 		r.Route("/sellers", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Sellers))
 
 			r.Get("/", c.SellerHandler.GetAll)
 			r.Get("/{id}", c.SellerHandler.GetById)
+			r.Get("/{id}/products", c.SellerHandler.GetProducts)
+			r.Get("/{id}/report", c.SellerHandler.GetReport)
 			r.Post("/", c.SellerHandler.Save)
 			r.Patch("/{id}", c.SellerHandler.Update)
 			r.Delete("/{id}", c.SellerHandler.Delete)
 		})
 
 		r.Route("/sections", func(rt chi.Router) {
+			rt.Use(handlers.Timeout(timeouts.Sections))
 			rt.Get("/", c.SectionHandler.GetAll)
 			rt.Get("/{id}", c.SectionHandler.GetByID)
 			rt.Get("/reportProducts", c.ProductBatchHandler.GetReportProduct)
@@ -73,6 +125,7 @@ func SetupRoutes(c *container.Container) *chi.Mux {
 		})
 
 		r.Route("/products", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Products))
 
 			r.Get("/", c.ProductHandler.GetAll)
 			r.Get("/{id}", c.ProductHandler.Get)
@@ -85,26 +138,54 @@ func SetupRoutes(c *container.Container) *chi.Mux {
 		})
 
 		r.Route("/productBatches", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.ProductBatches))
 			r.Post("/", c.ProductBatchHandler.Create)
 		})
 
 		r.Route("/purchaseOrders", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.PurchaseOrders))
 			r.Get("/", c.PurchaseOrderHandler.GetAll())
 			r.Post("/", c.PurchaseOrderHandler.PostPurchaseOrder())
 		})
-		r.Post("/productRecords", c.ProductRecordHandler.Create)
+		r.With(handlers.Timeout(timeouts.ProductRecords)).Post("/productRecords", c.ProductRecordHandler.Create)
+
+		r.Route("/countries", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Geography))
+			r.Get("/", c.GeographyHandler.GetCountries)
+			r.Get("/tree", c.GeographyHandler.GetTree)
+			r.Get("/{id}", c.GeographyHandler.GetCountryById)
+			r.Patch("/{id}", c.GeographyHandler.UpdateCountry)
+			r.Post("/{id}/merge", c.GeographyHandler.MergeCountries)
+		})
+
+		r.Route("/provinces", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Geography))
+			r.Get("/", c.GeographyHandler.GetProvinces)
+			r.Get("/{id}", c.GeographyHandler.GetProvinceById)
+			r.Patch("/{id}", c.GeographyHandler.UpdateProvince)
+			r.Post("/{id}/merge", c.GeographyHandler.MergeProvinces)
+		})
 
 		r.Route("/localities", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Geography))
+			r.Get("/", c.GeographyHandler.GetLocalities)
 			r.Post("/", c.LocalityHandler.Save)
 			r.Get("/reportSellers", c.LocalityHandler.GetSellerReportByLocality)
 			r.Get("/reportCarriers", c.CarryHandler.GetCarryReportByLocality)
+			r.Get("/dashboard", c.LocalityHandler.GetDashboard)
+			r.Get("/search", c.GeographyHandler.SearchLocalities)
+			r.Get("/{id}", c.GeographyHandler.GetLocalityById)
+			r.Patch("/{id}", c.GeographyHandler.UpdateLocality)
+			r.Post("/{id}/merge", c.GeographyHandler.MergeLocalities)
 		})
 
 		r.Route("/carriers", func(r chi.Router) {
+			r.Use(handlers.Timeout(timeouts.Carriers))
 			r.Post("/", c.CarryHandler.Create)
 		})
 
 		r.Route("/inboundOrders", func(rt chi.Router) {
+			rt.Use(handlers.Timeout(timeouts.InboundOrders))
 			rt.Post("/", c.InboundOrderHandler.PostInboundOrder())
 		})
 
@@ -0,0 +1,212 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/container"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/openapi"
+)
+
+const apiPrefix = "/api/v1"
+
+// documentedPath turns a chi pattern like /api/v1/buyers/ into its OpenAPI path /buyers
+// documentedPath convierte un patrón de chi como /api/v1/buyers/ en su ruta de OpenAPI /buyers
+func documentedPath(pattern string) string {
+	path := strings.TrimPrefix(pattern, apiPrefix)
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+func newRouter(t *testing.T) *chi.Mux {
+	t.Helper()
+	c, err := container.NewContainer(container.StorageMemory, nil)
+	if err != nil {
+		t.Fatalf("building memory container: %v", err)
+	}
+	return SetupRoutes(c, config.RouteTimeouts{})
+}
+
+// TestEveryRouteIsDocumented fails when a route is registered in SetupRoutes but missing from openapi.Routes, or the other way around
+// TestEveryRouteIsDocumented falla cuando una ruta se registra en SetupRoutes pero falta en openapi.Routes, o al revés
+func TestEveryRouteIsDocumented(t *testing.T) {
+	doc := openapi.Build(openapi.Routes)
+
+	registered := map[string]bool{}
+	err := chi.Walk(newRouter(t), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		path := documentedPath(route)
+		key := method + " " + path
+		registered[key] = true
+		if _, ok := doc.Paths[path][strings.ToLower(method)]; !ok {
+			t.Errorf("%s %s is registered but not documented in openapi.Routes", method, route)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walking routes: %v", err)
+	}
+
+	for _, route := range openapi.Routes {
+		if !registered[route.Method+" "+route.Path] {
+			t.Errorf("%s %s is documented but not registered in SetupRoutes", route.Method, route.Path)
+		}
+	}
+}
+
+// TestOpenAPIEndpoint checks the document is served and every schema reference resolves
+// TestOpenAPIEndpoint verifica que el documento se sirva y que cada referencia a schema exista
+func TestOpenAPIEndpoint(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newRouter(t).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, apiPrefix+"/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decoding document: %v", err)
+	}
+	if doc.OpenAPI == "" || len(doc.Paths) == 0 {
+		t.Fatalf("document is empty: %s", rec.Body.String())
+	}
+
+	for _, ref := range strings.Split(rec.Body.String(), `"$ref":"#/components/schemas/`)[1:] {
+		name := ref[:strings.Index(ref, `"`)]
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("schema %s is referenced but not defined", name)
+		}
+	}
+}
+
+// TestRequestValidation checks malformed params and bodies are rejected before reaching the handlers
+// TestRequestValidation verifica que los parámetros y cuerpos mal formados se rechacen antes de llegar a los handlers
+func TestRequestValidation(t *testing.T) {
+	router := newRouter(t)
+	cases := []struct {
+		name, method, path, body string
+		status                   int
+		field                    string
+	}{
+		{"unknown field", http.MethodPost, "/buyers", `{"id_card_number":"A1","first_name":"a","last_name":"b","age":3}`, http.StatusUnprocessableEntity, "age"},
+		{"wrong type", http.MethodPost, "/buyers", `{"id_card_number":7,"first_name":"a","last_name":"b"}`, http.StatusUnprocessableEntity, "id_card_number"},
+		{"unknown inbound order field", http.MethodPost, "/inboundOrders", `{"data":{"order_number":"O1","extra":1}}`, http.StatusUnprocessableEntity, "data.extra"},
+		{"unknown purchase order field", http.MethodPost, "/purchaseOrders", `{"data":{"order_number":"O1"},"extra":1}`, http.StatusUnprocessableEntity, "extra"},
+		{"malformed body", http.MethodPost, "/buyers", `{`, http.StatusBadRequest, ""},
+		{"path param", http.MethodGet, "/buyers/abc", "", http.StatusBadRequest, ""},
+		{"query param", http.MethodGet, "/provinces?country_id=x", "", http.StatusBadRequest, ""},
+		{"bad date", http.MethodGet, "/sellers/1/report?from=bad", "", http.StatusBadRequest, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tc.method, apiPrefix+tc.path, strings.NewReader(tc.body)))
+			if rec.Code != tc.status {
+				t.Fatalf("expected %d, got %d: %s", tc.status, rec.Code, rec.Body.String())
+			}
+
+			var body struct {
+				Errors map[string]string `json:"errors"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decoding error envelope: %v", err)
+			}
+			if tc.field != "" && body.Errors[tc.field] == "" {
+				t.Errorf("expected an error for field %s, got %v", tc.field, body.Errors)
+			}
+		})
+	}
+}
+
+// TestRouteTimeout checks a request that outlives the deadline of its group reaches the client as the 504 envelope,
+// through a real server whose write timeout is longer than the route deadline, as config.validate enforces
+// TestRouteTimeout verifica que una solicitud que supera el plazo de su grupo llegue al cliente como el sobre 504,
+// a través de un servidor real cuyo write timeout es mayor que el plazo de la ruta, como exige config.validate
+func TestRouteTimeout(t *testing.T) {
+	router := chi.NewRouter()
+	router.With(handlers.Timeout(20*time.Millisecond)).Get("/slow", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	server := httptest.NewUnstartedServer(router)
+	server.Config.WriteTimeout = 500 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/slow")
+	if err != nil {
+		t.Fatalf("the connection was dropped instead of answering 504: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusGatewayTimeout {
+		t.Fatalf("expected %d, got %d", http.StatusGatewayTimeout, res.StatusCode)
+	}
+
+	var body struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding error envelope: %v", err)
+	}
+	if body.Code != "deadline_exceeded" {
+		t.Errorf("expected code deadline_exceeded, got %q", body.Code)
+	}
+
+	// A route deadline at or past the write timeout, or disabled while it is set, would drop the connection instead
+	// Un plazo de ruta igual o mayor al write timeout, o desactivado mientras este está definido, cortaría la conexión
+	for _, value := range []string{"35s", "1m", "0"} {
+		t.Setenv("CONFIG_FILE", os.DevNull)
+		t.Setenv("APP_STORAGE", "memory")
+		t.Setenv("APP_WRITE_TIMEOUT", "35s")
+		t.Setenv("ROUTE_TIMEOUT_SELLERS", value)
+		if _, err := config.LoadConfig(); err == nil || !strings.Contains(err.Error(), "ROUTE_TIMEOUT_SELLERS") {
+			t.Errorf("ROUTE_TIMEOUT_SELLERS=%s: expected a validation error, got %v", value, err)
+		}
+	}
+}
+
+// TestSellerReportBadDate checks the handler itself answers 400 for a bad date, without the validator in front
+// TestSellerReportBadDate verifica que el handler responda 400 ante una fecha inválida, sin el validador delante
+func TestSellerReportBadDate(t *testing.T) {
+	c, err := container.NewContainer(container.StorageMemory, nil)
+	if err != nil {
+		t.Fatalf("building memory container: %v", err)
+	}
+	router := chi.NewRouter()
+	router.Get("/sellers/{id}/report", c.SellerHandler.GetReport)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sellers/1/report?from=bad", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected %d, got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+	}
+}
+
+// TestPanicIsCounted expects the 500 written by Recoverer to reach the request metrics
+// TestPanicIsCounted espera que el 500 escrito por Recoverer llegue a las métricas de solicitudes
+func TestPanicIsCounted(t *testing.T) {
+	router := newRouter(t)
+	router.Get("/panic", func(http.ResponseWriter, *http.Request) { panic("boom") })
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if !strings.Contains(rec.Body.String(), `http_requests_total{method="GET",route="/panic",status="500"} 1`) {
+		t.Errorf("expected the panic to be counted, got:\n%s", rec.Body.String())
+	}
+}
@@ -0,0 +1,180 @@
+package seed
+
+// reference resolves a foreign key column from one or more natural key fields of the fixture
+// reference resuelve una columna de clave foránea a partir de uno o más campos de clave natural del fixture
+type reference struct {
+	Column   string   // Column written in the target table / Columna escrita en la tabla destino
+	Fields   []string // Fixture fields passed as query arguments / Campos del fixture pasados como argumentos de la consulta
+	Query    string   // Query returning the referenced id / Consulta que retorna el id referenciado
+	Optional bool     // Allows empty fields, writing NULL / Permite campos vacíos, escribiendo NULL
+}
+
+// entity describes how a fixture file maps to a table
+// entity describe cómo un archivo de fixture se mapea a una tabla
+type entity struct {
+	Name    string      // Fixture file name without extension / Nombre del archivo de fixture sin extensión
+	Table   string      // Target table / Tabla destino
+	Keys    []string    // Natural key columns used for idempotent upserts / Columnas de clave natural usadas para upserts idempotentes
+	Columns []string    // Plain columns copied from the fixture / Columnas simples copiadas del fixture
+	Refs    []reference // Foreign keys resolved by natural keys / Claves foráneas resueltas por claves naturales
+
+	// Normalize names the column whose normalized form is written to normalized_name
+	// Normalize indica la columna cuya forma normalizada se escribe en normalized_name
+	Normalize string
+}
+
+// Reference lookup queries / Consultas de búsqueda de referencias
+const (
+	queryCountryByName      = "SELECT `id` FROM `countries` WHERE `country_name` = ?"
+	queryProvinceByName     = "SELECT p.`id` FROM `provinces` p INNER JOIN `countries` c ON c.`id` = p.`id_country_fk` WHERE c.`country_name` = ? AND p.`province_name` = ?"
+	queryLocalityByName     = "SELECT l.`id` FROM `localities` l INNER JOIN `provinces` p ON p.`id` = l.`province_id` INNER JOIN `countries` c ON c.`id` = p.`id_country_fk` WHERE c.`country_name` = ? AND p.`province_name` = ? AND l.`locality_name` = ?"
+	queryWarehouseByCode    = "SELECT `id` FROM `warehouse` WHERE `warehouse_code` = ?"
+	queryProductTypeByDesc  = "SELECT `id` FROM `products_types` WHERE `description` = ?"
+	querySellerByCid        = "SELECT `id` FROM `sellers` WHERE `cid` = ?"
+	queryProductByCode      = "SELECT `id` FROM `products` WHERE `product_code` = ?"
+	querySectionByNumber    = "SELECT s.`id` FROM `sections` s INNER JOIN `warehouse` w ON w.`id` = s.`warehouse_id` WHERE w.`warehouse_code` = ? AND s.`section_number` = ?"
+	queryEmployeeByCard     = "SELECT `id` FROM `employees` WHERE `id_card_number` = ?"
+	queryBatchByNumber      = "SELECT `id` FROM `product_batches` WHERE `batch_number` = ?"
+	queryBuyerByCard        = "SELECT `id` FROM `buyers` WHERE `id_card_number` = ?"
+	queryProductRecordByKey = "SELECT pr.`id` FROM `product_records` pr INNER JOIN `products` p ON p.`id` = pr.`product_id` WHERE p.`product_code` = ? AND pr.`last_update_date` = ?"
+	queryUserByName         = "SELECT `id` FROM `users` WHERE `username` = ?"
+	queryRolByName          = "SELECT `id` FROM `rol` WHERE `rol_name` = ?"
+)
+
+// localityFields is the natural key of a locality; names repeat across provinces and countries
+// localityFields es la clave natural de una localidad; los nombres se repiten entre provincias y países
+var localityFields = []string{"country_name", "province_name", "locality_name"}
+
+// entities lists every loadable fixture in dependency order
+// entities lista todos los fixtures cargables en orden de dependencias
+var entities = []entity{
+	{
+		Name: "countries", Table: "countries",
+		Keys:      []string{"country_name"},
+		Columns:   []string{"country_name"},
+		Normalize: "country_name",
+	},
+	{
+		Name: "provinces", Table: "provinces",
+		Keys:      []string{"province_name", "id_country_fk"},
+		Columns:   []string{"province_name"},
+		Normalize: "province_name",
+		Refs:      []reference{{Column: "id_country_fk", Fields: []string{"country_name"}, Query: queryCountryByName}},
+	},
+	{
+		Name: "localities", Table: "localities",
+		Keys:      []string{"locality_name", "province_id"},
+		Columns:   []string{"locality_name"},
+		Normalize: "locality_name",
+		Refs:      []reference{{Column: "province_id", Fields: []string{"country_name", "province_name"}, Query: queryProvinceByName}},
+	},
+	{
+		Name: "sellers", Table: "sellers",
+		Keys:    []string{"cid"},
+		Columns: []string{"cid", "company_name", "address", "telephone"},
+		Refs:    []reference{{Column: "locality_id", Fields: localityFields, Query: queryLocalityByName}},
+	},
+	{
+		Name: "buyers", Table: "buyers",
+		Keys:    []string{"id_card_number"},
+		Columns: []string{"id_card_number", "first_name", "last_name"},
+	},
+	{
+		Name: "warehouses", Table: "warehouse",
+		Keys:    []string{"warehouse_code"},
+		Columns: []string{"warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"},
+		Refs:    []reference{{Column: "locality_id", Fields: localityFields, Query: queryLocalityByName}},
+	},
+	{
+		Name: "employees", Table: "employees",
+		Keys:    []string{"id_card_number"},
+		Columns: []string{"id_card_number", "first_name", "last_name"},
+		Refs:    []reference{{Column: "warehouse_id", Fields: []string{"warehouse_code"}, Query: queryWarehouseByCode}},
+	},
+	{
+		Name: "product_types", Table: "products_types",
+		Keys:    []string{"description"},
+		Columns: []string{"description", "storage_class", "minimum_temperature", "maximum_temperature"},
+	},
+	{
+		Name: "sections", Table: "sections",
+		Keys:    []string{"warehouse_id", "section_number"},
+		Columns: []string{"section_number", "current_capacity", "current_temperature", "maximum_capacity", "minimum_capacity", "minimum_temperature", "maximum_volume", "maximum_weight"},
+		Refs: []reference{
+			{Column: "warehouse_id", Fields: []string{"warehouse_code"}, Query: queryWarehouseByCode},
+			{Column: "product_type_id", Fields: []string{"product_type"}, Query: queryProductTypeByDesc},
+		},
+	},
+	{
+		Name: "products", Table: "products",
+		Keys:    []string{"product_code"},
+		Columns: []string{"product_code", "description", "expiration_rate", "freezing_rate", "height", "length", "net_weight", "recommended_freezing_temperature", "width"},
+		Refs: []reference{
+			{Column: "product_type_id", Fields: []string{"product_type"}, Query: queryProductTypeByDesc},
+			{Column: "seller_id", Fields: []string{"seller_cid"}, Query: querySellerByCid, Optional: true},
+		},
+	},
+	{
+		Name: "product_records", Table: "product_records",
+		Keys:    []string{"product_id", "last_update_date"},
+		Columns: []string{"last_update_date", "purchase_price", "sale_price"},
+		Refs:    []reference{{Column: "product_id", Fields: []string{"product_code"}, Query: queryProductByCode}},
+	},
+	{
+		Name: "product_batches", Table: "product_batches",
+		Keys:    []string{"batch_number"},
+		Columns: []string{"batch_number", "current_quantity", "current_temperature", "due_date", "initial_quantity", "manufacturing_date", "manufacturing_hour", "minimum_temperature"},
+		Refs: []reference{
+			{Column: "product_id", Fields: []string{"product_code"}, Query: queryProductByCode},
+			{Column: "section_id", Fields: []string{"warehouse_code", "section_number"}, Query: querySectionByNumber},
+		},
+	},
+	{
+		Name: "carriers", Table: "carriers",
+		Keys:    []string{"cid"},
+		Columns: []string{"cid", "company_name", "address", "telephone"},
+		Refs:    []reference{{Column: "locality_id", Fields: localityFields, Query: queryLocalityByName}},
+	},
+	{
+		Name: "inbound_orders", Table: "inbound_orders",
+		Keys:    []string{"order_number"},
+		Columns: []string{"order_number", "order_date"},
+		Refs: []reference{
+			{Column: "employee_id", Fields: []string{"employee_card_number"}, Query: queryEmployeeByCard},
+			{Column: "product_batch_id", Fields: []string{"batch_number"}, Query: queryBatchByNumber},
+			{Column: "warehouse_id", Fields: []string{"warehouse_code"}, Query: queryWarehouseByCode},
+		},
+	},
+	{
+		Name: "order_status", Table: "order_status",
+		Keys:    []string{"description"},
+		Columns: []string{"description"},
+	},
+	{
+		Name: "purchase_orders", Table: "purchase_orders",
+		Keys:    []string{"order_number"},
+		Columns: []string{"order_number", "order_date", "tracking_code"},
+		Refs: []reference{
+			{Column: "buyer_id", Fields: []string{"buyer_card_number"}, Query: queryBuyerByCard},
+			{Column: "product_record_id", Fields: []string{"product_code", "record_date"}, Query: queryProductRecordByKey},
+		},
+	},
+	{
+		Name: "users", Table: "users",
+		Keys:    []string{"username"},
+		Columns: []string{"username", "password"},
+	},
+	{
+		Name: "roles", Table: "rol",
+		Keys:    []string{"rol_name"},
+		Columns: []string{"rol_name", "description"},
+	},
+	{
+		Name: "user_roles", Table: "user_rol",
+		Keys: []string{"usuario_id", "rol_id"},
+		Refs: []reference{
+			{Column: "usuario_id", Fields: []string{"username"}, Query: queryUserByName},
+			{Column: "rol_id", Fields: []string{"rol_name"}, Query: queryRolByName},
+		},
+	},
+}
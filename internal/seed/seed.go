@@ -0,0 +1,364 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
+)
+
+// ErrProfileNotFound is returned when the requested profile directory does not exist
+// ErrProfileNotFound se devuelve cuando el directorio del perfil solicitado no existe
+var ErrProfileNotFound = errors.New("seed: profile not found")
+
+// Result summarizes what happened to the rows of one fixture
+// Result resume qué ocurrió con las filas de un fixture
+type Result struct {
+	Entity    string
+	File      string
+	Inserted  int
+	Updated   int
+	Unchanged int
+}
+
+// record is a fixture row keyed by field name
+// record es una fila de fixture indexada por nombre de campo
+type record map[string]any
+
+// Loader loads fixture files of a profile into the database
+// Loader carga los archivos de fixtures de un perfil en la base de datos
+type Loader struct {
+	db  *sql.DB // Database connection / Conexión a la base de datos
+	dir string  // Root directory holding one folder per profile / Directorio raíz con una carpeta por perfil
+}
+
+// NewLoader creates a Loader reading profiles from dir
+// NewLoader crea un Loader que lee perfiles desde dir
+func NewLoader(db *sql.DB, dir string) *Loader {
+	return &Loader{db: db, dir: dir}
+}
+
+// Profiles lists the available profile names, one per sub directory
+// Profiles lista los nombres de perfiles disponibles, uno por subdirectorio
+func Profiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("seed: reading %s: %w", dir, err)
+	}
+
+	profiles := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
+
+// Load upserts every fixture of the profile inside a single transaction
+// Rows are matched by natural key so running it twice leaves the data unchanged
+// Load hace upsert de cada fixture del perfil dentro de una única transacción
+// Las filas se emparejan por clave natural, así que ejecutarlo dos veces deja los datos sin cambios
+func (l *Loader) Load(ctx context.Context, profile string) ([]Result, error) {
+	profileDir := filepath.Join(l.dir, profile)
+	if info, err := os.Stat(profileDir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, profile)
+	}
+
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("seed: starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	results := []Result{}
+	for _, e := range entities {
+		file, records, err := readFixture(profileDir, e.Name)
+		if err != nil {
+			return nil, err
+		}
+		if file == "" {
+			continue
+		}
+
+		result := Result{Entity: e.Name, File: file}
+		for i, rec := range records {
+			outcome, err := upsert(ctx, tx, e, rec)
+			if err != nil {
+				return nil, fmt.Errorf("seed: %s row %d: %w", file, i+1, err)
+			}
+			switch outcome {
+			case outcomeInserted:
+				result.Inserted++
+			case outcomeUpdated:
+				result.Updated++
+			default:
+				result.Unchanged++
+			}
+		}
+		results = append(results, result)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("seed: committing transaction: %w", err)
+	}
+	return results, nil
+}
+
+// readFixture loads <name>.json or <name>.csv from the profile directory; missing files are skipped
+// readFixture carga <name>.json o <name>.csv del directorio del perfil; los archivos faltantes se omiten
+func readFixture(dir, name string) (string, []record, error) {
+	jsonPath := filepath.Join(dir, name+".json")
+	csvPath := filepath.Join(dir, name+".csv")
+
+	switch {
+	case fileExists(jsonPath) && fileExists(csvPath):
+		return "", nil, fmt.Errorf("seed: both %s and %s exist, keep only one", jsonPath, csvPath)
+	case fileExists(jsonPath):
+		records, err := readJSON(jsonPath)
+		return jsonPath, records, err
+	case fileExists(csvPath):
+		records, err := readCSV(csvPath)
+		return csvPath, records, err
+	default:
+		return "", nil, nil
+	}
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// readJSON decodes an array of objects, keeping numbers as their literal text
+// readJSON decodifica un arreglo de objetos, manteniendo los números como su texto literal
+func readJSON(path string) ([]record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("seed: opening %s: %w", path, err)
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.UseNumber()
+
+	var rows []map[string]any
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("seed: decoding %s: %w", path, err)
+	}
+
+	records := make([]record, 0, len(rows))
+	for _, row := range rows {
+		rec := record{}
+		for field, value := range row {
+			if number, ok := value.(json.Number); ok {
+				value = number.String()
+			}
+			rec[field] = value
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// readCSV reads a header row followed by data rows; empty cells become NULL
+// readCSV lee una fila de encabezado seguida de filas de datos; las celdas vacías se convierten en NULL
+func readCSV(path string) ([]record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("seed: opening %s: %w", path, err)
+	}
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("seed: reading %s: %w", path, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	records := make([]record, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		rec := record{}
+		for i, field := range header {
+			if row[i] == "" {
+				rec[strings.TrimSpace(field)] = nil
+				continue
+			}
+			rec[strings.TrimSpace(field)] = row[i]
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+type outcome int
+
+const (
+	outcomeUnchanged outcome = iota
+	outcomeInserted
+	outcomeUpdated
+)
+
+// upsert resolves references, then inserts the row or updates the one matching its natural key
+// upsert resuelve las referencias y luego inserta la fila o actualiza la que coincide con su clave natural
+func upsert(ctx context.Context, tx *sql.Tx, e entity, rec record) (outcome, error) {
+	if err := checkFields(e, rec); err != nil {
+		return outcomeUnchanged, err
+	}
+
+	values := map[string]any{}
+	for _, column := range e.Columns {
+		values[column] = rec[column]
+	}
+	if e.Normalize != "" {
+		name, _ := rec[e.Normalize].(string)
+		values["normalized_name"] = tools.NormalizeName(name)
+	}
+
+	// Resolve foreign keys by natural key / Resolver claves foráneas por clave natural
+	for _, ref := range e.Refs {
+		id, err := resolve(ctx, tx, ref, rec)
+		if err != nil {
+			return outcomeUnchanged, err
+		}
+		values[ref.Column] = id
+	}
+
+	// Look up an existing row by natural key / Buscar una fila existente por clave natural
+	where := make([]string, 0, len(e.Keys))
+	keyArgs := make([]any, 0, len(e.Keys))
+	for _, key := range e.Keys {
+		if values[key] == nil {
+			return outcomeUnchanged, fmt.Errorf("natural key %q is required", key)
+		}
+		where = append(where, fmt.Sprintf("`%s` = ?", key))
+		keyArgs = append(keyArgs, values[key])
+	}
+	condition := strings.Join(where, " AND ")
+
+	var id int64
+	err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT `id` FROM `%s` WHERE %s", e.Table, condition), keyArgs...).Scan(&id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return outcomeUnchanged, err
+	}
+
+	columns := sortedColumns(values)
+
+	// Insert when the natural key is new / Insertar cuando la clave natural es nueva
+	if errors.Is(err, sql.ErrNoRows) {
+		placeholders := make([]string, len(columns))
+		args := make([]any, len(columns))
+		quoted := make([]string, len(columns))
+		for i, column := range columns {
+			quoted[i] = fmt.Sprintf("`%s`", column)
+			placeholders[i] = "?"
+			args[i] = values[column]
+		}
+		query := fmt.Sprintf("INSERT INTO `%s` (%s) VALUES (%s)", e.Table, strings.Join(quoted, ", "), strings.Join(placeholders, ", "))
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return outcomeUnchanged, err
+		}
+		return outcomeInserted, nil
+	}
+
+	// Otherwise update the non key columns / Si no, actualizar las columnas que no son clave
+	sets := []string{}
+	args := []any{}
+	for _, column := range columns {
+		if contains(e.Keys, column) {
+			continue
+		}
+		sets = append(sets, fmt.Sprintf("`%s` = ?", column))
+		args = append(args, values[column])
+	}
+	if len(sets) == 0 {
+		return outcomeUnchanged, nil
+	}
+
+	args = append(args, id)
+	result, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE `%s` SET %s WHERE `id` = ?", e.Table, strings.Join(sets, ", ")), args...)
+	if err != nil {
+		return outcomeUnchanged, err
+	}
+
+	// MySQL reports zero affected rows when nothing changed / MySQL reporta cero filas afectadas cuando nada cambió
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return outcomeUnchanged, nil
+	}
+	return outcomeUpdated, nil
+}
+
+// resolve runs the reference query with the fixture fields and returns the referenced id
+// resolve ejecuta la consulta de la referencia con los campos del fixture y retorna el id referenciado
+func resolve(ctx context.Context, tx *sql.Tx, ref reference, rec record) (any, error) {
+	args := make([]any, 0, len(ref.Fields))
+	for _, field := range ref.Fields {
+		if rec[field] == nil {
+			if ref.Optional {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("field %q is required to resolve %s", field, ref.Column)
+		}
+		args = append(args, rec[field])
+	}
+
+	var id int64
+	if err := tx.QueryRowContext(ctx, ref.Query, args...).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("no row found for %s with %v", ref.Column, args)
+		}
+		return nil, err
+	}
+	return id, nil
+}
+
+// checkFields rejects fixture fields that are neither columns nor reference fields, catching typos early
+// checkFields rechaza campos del fixture que no son columnas ni campos de referencia, detectando errores de tipeo
+func checkFields(e entity, rec record) error {
+	for field := range rec {
+		if contains(e.Columns, field) {
+			continue
+		}
+		known := false
+		for _, ref := range e.Refs {
+			if contains(ref.Fields, field) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown field %q for %s", field, e.Name)
+		}
+	}
+	return nil
+}
+
+func sortedColumns(values map[string]any) []string {
+	columns := make([]string, 0, len(values))
+	for column := range values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,263 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestReadFixture covers the JSON and CSV readers and the file selection of a profile
+// TestReadFixture cubre los lectores JSON y CSV y la selección de archivos de un perfil
+func TestReadFixture(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "product_records.json", `[{"product_code": "P-1", "sale_price": 1.50, "purchase_price": null}]`)
+	writeFile(t, dir, "sellers.csv", "cid, company_name,telephone\nS-1,Vendedor,\n")
+	writeFile(t, dir, "buyers.json", `[]`)
+	writeFile(t, dir, "buyers.csv", "id_card_number\n")
+
+	file, records, err := readFixture(dir, "product_records")
+	if err != nil {
+		t.Fatalf("reading JSON: %v", err)
+	}
+	if filepath.Base(file) != "product_records.json" || len(records) != 1 {
+		t.Fatalf("expected one record from product_records.json, got %d from %s", len(records), file)
+	}
+	// Numbers keep their literal text so decimals are not rounded / Los números conservan su texto literal para no redondear decimales
+	if records[0]["sale_price"] != "1.50" || records[0]["purchase_price"] != nil {
+		t.Errorf("unexpected JSON record %v", records[0])
+	}
+
+	file, records, err = readFixture(dir, "sellers")
+	if err != nil {
+		t.Fatalf("reading CSV: %v", err)
+	}
+	if filepath.Base(file) != "sellers.csv" || len(records) != 1 {
+		t.Fatalf("expected one record from sellers.csv, got %d from %s", len(records), file)
+	}
+	if records[0]["company_name"] != "Vendedor" || records[0]["telephone"] != nil {
+		t.Errorf("expected trimmed headers and NULL for empty cells, got %v", records[0])
+	}
+
+	if _, _, err := readFixture(dir, "buyers"); err == nil {
+		t.Error("expected an error when both JSON and CSV exist")
+	}
+	if file, records, err := readFixture(dir, "carriers"); err != nil || file != "" || records != nil {
+		t.Errorf("expected a missing fixture to be skipped, got (%q, %v, %v)", file, records, err)
+	}
+}
+
+// TestFixturesUseKnownFields checks every fixture of the shipped profiles against the entity definitions
+// TestFixturesUseKnownFields valida cada fixture de los perfiles incluidos contra las definiciones de entidades
+func TestFixturesUseKnownFields(t *testing.T) {
+	dir := filepath.Join("..", "..", "docs", "database", "fixtures")
+	profiles, err := Profiles(dir)
+	if err != nil {
+		t.Fatalf("listing profiles: %v", err)
+	}
+
+	for _, profile := range profiles {
+		for _, e := range entities {
+			file, records, err := readFixture(filepath.Join(dir, profile), e.Name)
+			if err != nil {
+				t.Fatalf("%s/%s: %v", profile, e.Name, err)
+			}
+			for i, rec := range records {
+				if err := checkFields(e, rec); err != nil {
+					t.Errorf("%s row %d: %v", file, i+1, err)
+				}
+			}
+		}
+	}
+}
+
+// TestUpsertResolvesLocalityByParent links a repeated locality name to the one of the given province
+// TestUpsertResolvesLocalityByParent vincula un nombre de localidad repetido con el de la provincia indicada
+func TestUpsertResolvesLocalityByParent(t *testing.T) {
+	db := newFakeDB(t)
+	db.rows[lookupKey(queryLocalityByName, "Argentina", "Mendoza", "San Martín")] = 7
+	db.rows[lookupKey(queryLocalityByName, "Argentina", "San Juan", "San Martín")] = 9
+	sellers := findEntity(t, "sellers")
+
+	tx := db.begin(t)
+	outcome, err := upsert(context.Background(), tx, sellers, record{
+		"cid": "S-1", "company_name": "Vendedor", "address": "Calle 1", "telephone": "555",
+		"country_name": "Argentina", "province_name": "San Juan", "locality_name": "San Martín",
+	})
+	if err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if outcome != outcomeInserted {
+		t.Fatalf("expected the seller to be inserted, got %v", outcome)
+	}
+
+	insert := db.execs[len(db.execs)-1]
+	expected := "INSERT INTO `sellers` (`address`, `cid`, `company_name`, `locality_id`, `telephone`) VALUES (?, ?, ?, ?, ?)"
+	if insert.query != expected {
+		t.Fatalf("unexpected insert %q", insert.query)
+	}
+	if insert.args[3] != int64(9) {
+		t.Errorf("expected locality 9 of San Juan, got %v", insert.args[3])
+	}
+
+	_, err = upsert(context.Background(), tx, sellers, record{
+		"cid": "S-2", "company_name": "Vendedor", "address": "Calle 1", "telephone": "555",
+		"country_name": "Argentina", "locality_name": "San Martín",
+	})
+	if err == nil || !strings.Contains(err.Error(), `"province_name"`) {
+		t.Errorf("expected the missing province to be reported, got %v", err)
+	}
+
+	_, err = upsert(context.Background(), tx, sellers, record{
+		"cid": "S-3", "company_name": "Vendedor", "address": "Calle 1", "telephone": "555",
+		"country_name": "Argentina", "province_name": "Salta", "locality_name": "San Martín",
+	})
+	if err == nil || !strings.Contains(err.Error(), "no row found for locality_id") {
+		t.Errorf("expected an unknown locality to be reported, got %v", err)
+	}
+}
+
+// TestUpsertOutcomes covers insert, update, unchanged and unknown fields on the natural key of a province
+// TestUpsertOutcomes cubre inserción, actualización, sin cambios y campos desconocidos sobre la clave natural de una provincia
+func TestUpsertOutcomes(t *testing.T) {
+	db := newFakeDB(t)
+	db.rows[lookupKey(queryCountryByName, "Argentina")] = 1
+	provinces := findEntity(t, "provinces")
+	selectExisting := "SELECT `id` FROM `provinces` WHERE `province_name` = ? AND `id_country_fk` = ?"
+	tx := db.begin(t)
+	rec := record{"province_name": "Córdoba", "country_name": "Argentina"}
+
+	outcome, err := upsert(context.Background(), tx, provinces, rec)
+	if err != nil || outcome != outcomeInserted {
+		t.Fatalf("expected an insert, got (%v, %v)", outcome, err)
+	}
+	insert := db.execs[len(db.execs)-1]
+	if insert.args[1] != "cordoba" {
+		t.Errorf("expected the normalized name to be written, got %v", insert.args)
+	}
+
+	db.rows[lookupKey(selectExisting, "Córdoba", int64(1))] = 5
+	db.affected = 0
+	if outcome, err := upsert(context.Background(), tx, provinces, rec); err != nil || outcome != outcomeUnchanged {
+		t.Errorf("expected an unchanged row, got (%v, %v)", outcome, err)
+	}
+
+	db.affected = 1
+	if outcome, err := upsert(context.Background(), tx, provinces, rec); err != nil || outcome != outcomeUpdated {
+		t.Errorf("expected an update, got (%v, %v)", outcome, err)
+	}
+	update := db.execs[len(db.execs)-1]
+	if update.query != "UPDATE `provinces` SET `normalized_name` = ? WHERE `id` = ?" || update.args[1] != int64(5) {
+		t.Errorf("unexpected update %q %v", update.query, update.args)
+	}
+
+	if _, err := upsert(context.Background(), tx, provinces, record{"province_name": "Córdoba", "pais": "Argentina"}); err == nil {
+		t.Error("expected an unknown field to be rejected")
+	}
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func findEntity(t *testing.T, name string) entity {
+	t.Helper()
+	for _, e := range entities {
+		if e.Name == name {
+			return e
+		}
+	}
+	t.Fatalf("entity %s not found", name)
+	return entity{}
+}
+
+// fakeDB is a database/sql driver answering SELECT id lookups from a map and recording writes
+// fakeDB es un driver de database/sql que responde las búsquedas SELECT id desde un mapa y registra las escrituras
+type fakeDB struct {
+	*sql.DB
+	rows     map[string]int64 // Lookup key to returned id / Clave de búsqueda a id devuelto
+	execs    []fakeExec       // Executed statements / Sentencias ejecutadas
+	affected int64            // Rows affected reported by every exec / Filas afectadas reportadas por cada exec
+}
+
+type fakeExec struct {
+	query string
+	args  []any
+}
+
+func newFakeDB(t *testing.T) *fakeDB {
+	t.Helper()
+	db := &fakeDB{rows: map[string]int64{}, affected: 1}
+	db.DB = sql.OpenDB(db)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func (db *fakeDB) begin(t *testing.T) *sql.Tx {
+	t.Helper()
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { tx.Rollback() })
+	return tx
+}
+
+func lookupKey(query string, args ...any) string {
+	return fmt.Sprintf("%s %v", query, args)
+}
+
+func (db *fakeDB) Connect(context.Context) (driver.Conn, error) { return fakeConn{db}, nil }
+func (db *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c fakeConn) Commit() error                       { return nil }
+func (c fakeConn) Rollback() error                     { return nil }
+
+func (c fakeConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	id, ok := c.db.rows[lookupKey(query, values(named)...)]
+	return &fakeRows{id: id, done: !ok}, nil
+}
+
+func (c fakeConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	c.db.execs = append(c.db.execs, fakeExec{query: query, args: values(named)})
+	return driver.RowsAffected(c.db.affected), nil
+}
+
+func values(named []driver.NamedValue) []any {
+	args := make([]any, len(named))
+	for i, value := range named {
+		args[i] = value.Value
+	}
+	return args
+}
+
+type fakeRows struct {
+	id   int64
+	done bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"id"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.id
+	return nil
+}
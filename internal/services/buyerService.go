@@ -8,21 +8,15 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var buyerServiceInstance BuyerServiceI
-
-// GetBuyerService - Creates and returns a new instance of BuyerService with the required repository using singleton pattern
-// GetBuyerService - Crea y retorna una nueva instancia de BuyerService con el repositorio requerido usando patrón singleton
+// GetBuyerService - Creates and returns a new instance of BuyerService with the required repository
+// GetBuyerService - Crea y retorna una nueva instancia de BuyerService con el repositorio requerido
 func GetBuyerService(repo repositories.BuyerRepositoryI) BuyerServiceI {
-	if buyerServiceInstance != nil {
-		return buyerServiceInstance
-	}
-
-	buyerServiceInstance = &BuyerService{
+	return &BuyerService{
 		repository: repo,
 	}
-	return buyerServiceInstance
 }
 
 // BuyerServiceI - Interface defining the contract for buyer service operations with business logic
@@ -58,27 +52,39 @@ type BuyerService struct {
 // GetAll - Delegates retrieving all buyers to the repository
 // GetAll - Delega la obtención de todos los compradores al repositorio
 func (s *BuyerService) GetAll(ctx context.Context) (map[int]models.Buyer, error) {
+	ctx, span := tracing.Start(ctx, "services.BuyerService.GetAll")
+	defer span.End()
+
 	return s.repository.GetAll(ctx)
 }
 
 // GetById - Delegates retrieving a buyer by their ID to the repository
 // GetById - Delega la obtención de un comprador por su ID al repositorio
 func (s *BuyerService) GetById(ctx context.Context, id int) (models.Buyer, error) {
+	ctx, span := tracing.Start(ctx, "services.BuyerService.GetById")
+	defer span.End()
+
 	return s.repository.GetById(ctx, id)
 }
 
 // DeleteById - Delegates removing a buyer from the repository by their ID
 // DeleteById - Delega la eliminación de un comprador del repositorio por su ID
 func (s *BuyerService) DeleteById(ctx context.Context, id int) error {
+	ctx, span := tracing.Start(ctx, "services.BuyerService.DeleteById")
+	defer span.End()
+
 	return s.repository.DeleteById(ctx, id)
 }
 
 // Create - Creates a new buyer with business validation to ensure card number uniqueness
 // Create - Crea un nuevo comprador con validación de negocio para asegurar la unicidad del número de tarjeta
 func (s *BuyerService) Create(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
+	ctx, span := tracing.Start(ctx, "services.BuyerService.Create")
+	defer span.End()
+
 	// Business validation: Get all existing card numbers to check for duplicates
 	// Validación de negocio: Obtener todos los números de tarjeta existentes para verificar duplicados
-	existingCardNumbers, err := s.repository.GetCardNumberIds()
+	existingCardNumbers, err := s.repository.GetCardNumberIds(ctx)
 	if err != nil {
 		return models.Buyer{}, err
 	}
@@ -97,9 +103,12 @@ func (s *BuyerService) Create(ctx context.Context, buyer models.Buyer) (models.B
 // Update - Updates an existing buyer with business validation to ensure card number uniqueness
 // Update - Actualiza un comprador existente con validación de negocio para asegurar la unicidad del número de tarjeta
 func (s *BuyerService) Update(ctx context.Context, id int, buyer models.Buyer) (models.Buyer, error) {
+	ctx, span := tracing.Start(ctx, "services.BuyerService.Update")
+	defer span.End()
+
 	// Business validation: Get all existing card numbers to check for duplicates
 	// Validación de negocio: Obtener todos los números de tarjeta existentes para verificar duplicados
-	existingCardNumbers, err := s.repository.GetCardNumberIds()
+	existingCardNumbers, err := s.repository.GetCardNumberIds(ctx)
 	if err != nil {
 		return models.Buyer{}, err
 	}
@@ -2,24 +2,20 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var carryServiceInstance CarryService
-
-// NewCarryService - Creates and returns a new instance of CarryServiceImpl with required repositories using singleton pattern
-// NewCarryService - Crea y retorna una nueva instancia de CarryServiceImpl con los repositorios requeridos usando patrón singleton
+// NewCarryService - Creates and returns a new instance of CarryServiceImpl with required repositories
+// NewCarryService - Crea y retorna una nueva instancia de CarryServiceImpl con los repositorios requeridos
 func NewCarryService(r repositories.CarryRepository, lr repositories.LocalityRepository) CarryService {
-	if carryServiceInstance != nil {
-		return carryServiceInstance
-	}
-	carryServiceInstance = &CarryServiceImpl{carryRepository: r, localityRepository: lr}
-	return carryServiceInstance
+	return &CarryServiceImpl{carryRepository: r, localityRepository: lr}
 }
 
 // CarryService - Interface defining the contract for carry service operations with business logic
@@ -44,11 +40,14 @@ type CarryServiceImpl struct {
 // CreateCarry - Creates a new carry with comprehensive business validation
 // CreateCarry - Crea un nuevo transportista con validación integral de negocio
 func (s *CarryServiceImpl) CreateCarry(ctx context.Context, carry models.Carry) (models.Carry, error) {
+	ctx, span := tracing.Start(ctx, "services.CarryServiceImpl.CreateCarry")
+	defer span.End()
+
 	// Business validation: Verify that the locality exists before creating the carry
 	// Validación de negocio: Verificar que la localidad existe antes de crear el transportista
 	localityExists, err := s.localityRepository.ExistById(ctx, carry.LocalityId)
 	if err != nil {
-		return models.Carry{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Carry{}, internalError(ctx, err)
 	}
 	if !localityExists {
 		return models.Carry{}, fmt.Errorf("%w: locality with id %d", error_message.ErrNotFound, carry.LocalityId)
@@ -58,7 +57,7 @@ func (s *CarryServiceImpl) CreateCarry(ctx context.Context, carry models.Carry)
 	// Regla de negocio: El CID debe ser único entre todos los transportistas
 	exists, err := s.carryRepository.ExistsByCid(ctx, carry.Cid)
 	if err != nil {
-		return models.Carry{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Carry{}, internalError(ctx, err)
 	}
 	if exists {
 		return models.Carry{}, fmt.Errorf("%w: resource with the provided identifier already exists", error_message.ErrAlreadyExists)
@@ -68,31 +67,28 @@ func (s *CarryServiceImpl) CreateCarry(ctx context.Context, carry models.Carry)
 	// Si todas las validaciones pasan, delegar al repositorio para la persistencia
 	carry, err = s.carryRepository.Create(ctx, carry)
 	if err != nil {
-		return models.Carry{}, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return models.Carry{}, internalError(ctx, err)
 	}
 	return carry, nil
 }
 
-// GetCarryReportByLocality - Retrieves carry reports with optional locality validation
-// GetCarryReportByLocality - Obtiene reportes de transportistas con validación opcional de localidad
+// GetCarryReportByLocality - Projects the locality dashboard onto the carrier counts
+// GetCarryReportByLocality - Proyecta el tablero de localidades sobre los conteos de transportistas
 func (s *CarryServiceImpl) GetCarryReportByLocality(ctx context.Context, localityID int) ([]responses.LocalityCarryReport, error) {
-	// Business validation: Only validate locality existence if a specific locality ID is provided
-	// Validación de negocio: Solo validar la existencia de la localidad si se proporciona un ID de localidad específico
-	if localityID != 0 {
-		exists, err := s.localityRepository.ExistById(ctx, localityID)
-		if err != nil {
-			return nil, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
-		}
-		if !exists {
-			return nil, fmt.Errorf("%w: locality with id %d", error_message.ErrNotFound, localityID)
+	ctx, span := tracing.Start(ctx, "services.CarryServiceImpl.GetCarryReportByLocality")
+	defer span.End()
+
+	dashboard, err := localityDashboard(ctx, s.localityRepository, models.GeographyLevelLocality, localityID)
+	if err != nil {
+		if errors.Is(err, error_message.ErrNotFound) {
+			return nil, err
 		}
+		return nil, internalError(ctx, err)
 	}
 
-	// Delegate to repository for data retrieval (if localityID = 0, returns all localities)
-	// Delegar al repositorio para la recuperación de datos (si localityID = 0, retorna todas las localidades)
-	reports, err := s.carryRepository.GetCarryReportsByLocality(ctx, localityID)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+	reports := make([]responses.LocalityCarryReport, 0, len(dashboard))
+	for _, row := range dashboard {
+		reports = append(reports, responses.LocalityCarryReport{LocalityId: row.Id, LocalityName: row.Name, CarriersCount: row.Carriers})
 	}
 	return reports, nil
 }
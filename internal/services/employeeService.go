@@ -8,20 +8,15 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var employeeServiceInstance EmployeeServiceI
-
-// GetEmployeeService - Creates and returns a new instance of EmployeeService with the required repository using singleton pattern
-// GetEmployeeService - Crea y retorna una nueva instancia de EmployeeService con el repositorio requerido usando patrón singleton
+// GetEmployeeService - Creates and returns a new instance of EmployeeService with the required repository
+// GetEmployeeService - Crea y retorna una nueva instancia de EmployeeService con el repositorio requerido
 func GetEmployeeService(repository repositories.EmployeeRepositoryI) EmployeeServiceI {
-	if employeeServiceInstance != nil {
-		return employeeServiceInstance
-	}
-	employeeServiceInstance = &EmployeeService{
+	return &EmployeeService{
 		repository: repository,
 	}
-	return employeeServiceInstance
 }
 
 // EmployeeServiceI - Interface defining the contract for employee service operations with business logic
@@ -57,6 +52,9 @@ type EmployeeService struct {
 // GetAll - Delegates retrieving all employees to the repository
 // GetAll - Delega la obtención de todos los empleados al repositorio
 func (s *EmployeeService) GetAll(ctx context.Context) (map[int]models.Employee, error) {
+	ctx, span := tracing.Start(ctx, "services.EmployeeService.GetAll")
+	defer span.End()
+
 	return s.repository.GetAll(ctx)
 
 }
@@ -64,21 +62,30 @@ func (s *EmployeeService) GetAll(ctx context.Context) (map[int]models.Employee,
 // GetById - Delegates retrieving an employee by their ID to the repository
 // GetById - Delega la obtención de un empleado por su ID al repositorio
 func (s *EmployeeService) GetById(ctx context.Context, id int) (models.Employee, error) {
+	ctx, span := tracing.Start(ctx, "services.EmployeeService.GetById")
+	defer span.End()
+
 	return s.repository.GetById(ctx, id)
 }
 
 // DeleteById - Delegates removing an employee from the repository by their ID
 // DeleteById - Delega la eliminación de un empleado del repositorio por su ID
 func (s *EmployeeService) DeleteById(ctx context.Context, id int) error {
+	ctx, span := tracing.Start(ctx, "services.EmployeeService.DeleteById")
+	defer span.End()
+
 	return s.repository.DeleteById(ctx, id)
 }
 
 // Create - Creates a new employee with business validation to ensure card number uniqueness
 // Create - Crea un nuevo empleado con validación de negocio para asegurar la unicidad del número de tarjeta
 func (s *EmployeeService) Create(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	ctx, span := tracing.Start(ctx, "services.EmployeeService.Create")
+	defer span.End()
+
 	// Business validation: Get all existing card numbers to check for duplicates
 	// Validación de negocio: Obtener todos los números de tarjeta existentes para verificar duplicados
-	existingCardNumbers, err := s.repository.GetCardNumberIds()
+	existingCardNumbers, err := s.repository.GetCardNumberIds(ctx)
 	if err != nil {
 		return models.Employee{}, err
 	}
@@ -97,9 +104,12 @@ func (s *EmployeeService) Create(ctx context.Context, employee models.Employee)
 // Update - Updates an existing employee with business validation to ensure card number uniqueness
 // Update - Actualiza un empleado existente con validación de negocio para asegurar la unicidad del número de tarjeta
 func (s *EmployeeService) Update(ctx context.Context, employeeId int, employee models.Employee) (models.Employee, error) {
+	ctx, span := tracing.Start(ctx, "services.EmployeeService.Update")
+	defer span.End()
+
 	// Business validation: Get all existing card numbers to check for duplicates
 	// Validación de negocio: Obtener todos los números de tarjeta existentes para verificar duplicados
-	existingCardNumbers, err := s.repository.GetCardNumberIds()
+	existingCardNumbers, err := s.repository.GetCardNumberIds(ctx)
 	if err != nil {
 		return models.Employee{}, err
 	}
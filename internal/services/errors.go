@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/logging"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
+)
+
+// loggedSentinels are wrapped by the repositories after logging the failure / loggedSentinels los envuelven los repositorios después de registrar la falla
+var loggedSentinels = []error{
+	error_message.ErrInternalServerError,
+	error_message.ErrQuery,
+	error_message.ErrQueryingReport,
+	error_message.ErrFailedToScan,
+}
+
+// internalError logs an unexpected failure with the request ID of ctx, marks the span of ctx as failed and wraps it as an internal server error
+// The log record points at the service method that called internalError; failures the repository already logged are not logged again
+// internalError registra una falla inesperada con el ID de solicitud de ctx, marca el span de ctx como fallido y la envuelve como error interno del servidor
+// El registro apunta al método del servicio que llamó a internalError; las fallas que el repositorio ya registró no se registran de nuevo
+func internalError(ctx context.Context, err error) error {
+	tracing.Fail(ctx, err)
+	if !isLogged(err) {
+		logging.Error(ctx, 1, "service: unexpected error", err)
+	}
+	return fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+}
+
+func isLogged(err error) bool {
+	for _, sentinel := range loggedSentinels {
+		if errors.Is(err, sentinel) {
+			return true
+		}
+	}
+	return false
+}
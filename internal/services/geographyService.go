@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
+	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
+)
+
+// NewGeographyService creates and returns a new instance of GeographyServiceImpl with the required repository
+// NewGeographyService crea y retorna una nueva instancia de GeographyServiceImpl con el repositorio requerido
+func NewGeographyService(geographyRepository repositories.GeographyRepository) GeographyService {
+	return &GeographyServiceImpl{geographyRepository: geographyRepository}
+}
+
+// GeographyService defines the contract for reading, fixing and merging countries, provinces and localities
+// GeographyService define el contrato para leer, corregir y fusionar países, provincias y localidades
+type GeographyService interface {
+	GetCountries(ctx context.Context) ([]models.Country, error)
+	GetCountryById(ctx context.Context, id int) (models.Country, error)
+	UpdateCountry(ctx context.Context, country models.Country) (models.Country, error)
+	MergeCountries(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)
+
+	GetProvinces(ctx context.Context, countryId int) ([]models.Province, error)
+	GetProvinceById(ctx context.Context, id int) (models.Province, error)
+	UpdateProvince(ctx context.Context, province models.Province) (models.Province, error)
+	MergeProvinces(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)
+
+	GetLocalities(ctx context.Context, provinceId int) ([]models.Locality, error)
+	GetLocalityById(ctx context.Context, id int) (models.Locality, error)
+	UpdateLocality(ctx context.Context, locality models.Locality) (models.Locality, error)
+	MergeLocalities(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error)
+
+	// SearchLocalities finds localities whose locality, province or country name matches the query
+	// ignoring case and accents, by prefix of the name or any of its words, or within a small edit distance
+	// SearchLocalities busca localidades cuyo nombre de localidad, provincia o país coincide con la consulta
+	// ignorando mayúsculas y acentos, por prefijo del nombre o de cualquiera de sus palabras, o a poca distancia de edición
+	SearchLocalities(ctx context.Context, query string, limit int) ([]models.LocalityMatch, error)
+
+	GetTree(ctx context.Context) ([]models.CountryNode, error)
+}
+
+// GeographyServiceImpl implements GeographyService and keeps names unique within their parent
+// GeographyServiceImpl implementa GeographyService y mantiene los nombres únicos dentro de su padre
+type GeographyServiceImpl struct {
+	geographyRepository repositories.GeographyRepository // Repository for geography data access / Repositorio para acceso a datos de geografía
+}
+
+// GetCountries retrieves all countries / GetCountries recupera todos los países
+func (s *GeographyServiceImpl) GetCountries(ctx context.Context) ([]models.Country, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetCountries")
+	defer span.End()
+
+	return s.geographyRepository.GetCountries(ctx)
+}
+
+// GetCountryById retrieves a country by its ID / GetCountryById recupera un país por su ID
+func (s *GeographyServiceImpl) GetCountryById(ctx context.Context, id int) (models.Country, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetCountryById")
+	defer span.End()
+
+	return s.geographyRepository.GetCountryById(ctx, id)
+}
+
+// UpdateCountry renames a country, rejecting names already used by another country
+// UpdateCountry renombra un país, rechazando nombres ya usados por otro país
+func (s *GeographyServiceImpl) UpdateCountry(ctx context.Context, country models.Country) (models.Country, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.UpdateCountry")
+	defer span.End()
+
+	if _, err := s.geographyRepository.GetCountryById(ctx, country.Id); err != nil {
+		return models.Country{}, err
+	}
+
+	countries, err := s.geographyRepository.GetCountries(ctx)
+	if err != nil {
+		return models.Country{}, err
+	}
+	for _, other := range countries {
+		if other.Id != country.Id && tools.NormalizeName(other.CountryName) == tools.NormalizeName(country.CountryName) {
+			return models.Country{}, fmt.Errorf("%w: country %s has id %d, merge instead", error_message.ErrAlreadyExists, other.CountryName, other.Id)
+		}
+	}
+
+	if err := s.geographyRepository.UpdateCountry(ctx, country); err != nil {
+		return models.Country{}, err
+	}
+	return s.geographyRepository.GetCountryById(ctx, country.Id)
+}
+
+// MergeCountries folds the source country into the target / MergeCountries fusiona el país origen en el destino
+func (s *GeographyServiceImpl) MergeCountries(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.MergeCountries")
+	defer span.End()
+
+	if err := checkMergeIds(sourceId, targetId); err != nil {
+		return models.GeographyMergeResult{}, err
+	}
+	if _, err := s.geographyRepository.GetCountryById(ctx, sourceId); err != nil {
+		return models.GeographyMergeResult{}, err
+	}
+	if _, err := s.geographyRepository.GetCountryById(ctx, targetId); err != nil {
+		return models.GeographyMergeResult{}, dependencyError(err, "country", targetId)
+	}
+	return s.geographyRepository.MergeCountries(ctx, sourceId, targetId)
+}
+
+// GetProvinces retrieves provinces, optionally filtered by country / GetProvinces recupera provincias, opcionalmente filtradas por país
+func (s *GeographyServiceImpl) GetProvinces(ctx context.Context, countryId int) ([]models.Province, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetProvinces")
+	defer span.End()
+
+	return s.geographyRepository.GetProvinces(ctx, countryId)
+}
+
+// GetProvinceById retrieves a province by its ID / GetProvinceById recupera una provincia por su ID
+func (s *GeographyServiceImpl) GetProvinceById(ctx context.Context, id int) (models.Province, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetProvinceById")
+	defer span.End()
+
+	return s.geographyRepository.GetProvinceById(ctx, id)
+}
+
+// UpdateProvince renames or moves a province, checking the country exists and the name is free inside it
+// UpdateProvince renombra o mueve una provincia, verificando que el país exista y que el nombre esté libre en él
+func (s *GeographyServiceImpl) UpdateProvince(ctx context.Context, province models.Province) (models.Province, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.UpdateProvince")
+	defer span.End()
+
+	if _, err := s.geographyRepository.GetProvinceById(ctx, province.Id); err != nil {
+		return models.Province{}, err
+	}
+	if _, err := s.geographyRepository.GetCountryById(ctx, province.CountryId); err != nil {
+		return models.Province{}, dependencyError(err, "country", province.CountryId)
+	}
+
+	siblings, err := s.geographyRepository.GetProvinces(ctx, province.CountryId)
+	if err != nil {
+		return models.Province{}, err
+	}
+	for _, other := range siblings {
+		if other.Id != province.Id && tools.NormalizeName(other.ProvinceName) == tools.NormalizeName(province.ProvinceName) {
+			return models.Province{}, fmt.Errorf("%w: province %s has id %d in country %d, merge instead", error_message.ErrAlreadyExists, other.ProvinceName, other.Id, province.CountryId)
+		}
+	}
+
+	if err := s.geographyRepository.UpdateProvince(ctx, province); err != nil {
+		return models.Province{}, err
+	}
+	return s.geographyRepository.GetProvinceById(ctx, province.Id)
+}
+
+// MergeProvinces folds the source province into the target / MergeProvinces fusiona la provincia origen en la destino
+func (s *GeographyServiceImpl) MergeProvinces(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.MergeProvinces")
+	defer span.End()
+
+	if err := checkMergeIds(sourceId, targetId); err != nil {
+		return models.GeographyMergeResult{}, err
+	}
+	if _, err := s.geographyRepository.GetProvinceById(ctx, sourceId); err != nil {
+		return models.GeographyMergeResult{}, err
+	}
+	if _, err := s.geographyRepository.GetProvinceById(ctx, targetId); err != nil {
+		return models.GeographyMergeResult{}, dependencyError(err, "province", targetId)
+	}
+	return s.geographyRepository.MergeProvinces(ctx, sourceId, targetId)
+}
+
+// GetLocalities retrieves localities, optionally filtered by province / GetLocalities recupera localidades, opcionalmente filtradas por provincia
+func (s *GeographyServiceImpl) GetLocalities(ctx context.Context, provinceId int) ([]models.Locality, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetLocalities")
+	defer span.End()
+
+	return s.geographyRepository.GetLocalities(ctx, provinceId)
+}
+
+// GetLocalityById retrieves a locality by its ID / GetLocalityById recupera una localidad por su ID
+func (s *GeographyServiceImpl) GetLocalityById(ctx context.Context, id int) (models.Locality, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetLocalityById")
+	defer span.End()
+
+	return s.geographyRepository.GetLocalityById(ctx, id)
+}
+
+// UpdateLocality renames or moves a locality, checking the province exists and the name is free inside it
+// UpdateLocality renombra o mueve una localidad, verificando que la provincia exista y que el nombre esté libre en ella
+func (s *GeographyServiceImpl) UpdateLocality(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.UpdateLocality")
+	defer span.End()
+
+	if _, err := s.geographyRepository.GetLocalityById(ctx, locality.Id); err != nil {
+		return models.Locality{}, err
+	}
+	if _, err := s.geographyRepository.GetProvinceById(ctx, locality.ProvinceId); err != nil {
+		return models.Locality{}, dependencyError(err, "province", locality.ProvinceId)
+	}
+
+	siblings, err := s.geographyRepository.GetLocalities(ctx, locality.ProvinceId)
+	if err != nil {
+		return models.Locality{}, err
+	}
+	for _, other := range siblings {
+		if other.Id != locality.Id && tools.NormalizeName(other.LocalityName) == tools.NormalizeName(locality.LocalityName) {
+			return models.Locality{}, fmt.Errorf("%w: locality %s has id %d in province %d, merge instead", error_message.ErrAlreadyExists, other.LocalityName, other.Id, locality.ProvinceId)
+		}
+	}
+
+	if err := s.geographyRepository.UpdateLocality(ctx, locality); err != nil {
+		return models.Locality{}, err
+	}
+	return s.geographyRepository.GetLocalityById(ctx, locality.Id)
+}
+
+// MergeLocalities folds a duplicate locality into the target, repointing sellers, carriers and warehouses
+// MergeLocalities fusiona una localidad duplicada en la destino, reasignando vendedores, transportistas y almacenes
+func (s *GeographyServiceImpl) MergeLocalities(ctx context.Context, sourceId, targetId int) (models.GeographyMergeResult, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.MergeLocalities")
+	defer span.End()
+
+	if err := checkMergeIds(sourceId, targetId); err != nil {
+		return models.GeographyMergeResult{}, err
+	}
+	if _, err := s.geographyRepository.GetLocalityById(ctx, sourceId); err != nil {
+		return models.GeographyMergeResult{}, err
+	}
+	if _, err := s.geographyRepository.GetLocalityById(ctx, targetId); err != nil {
+		return models.GeographyMergeResult{}, dependencyError(err, "locality", targetId)
+	}
+	return s.geographyRepository.MergeLocalities(ctx, sourceId, targetId)
+}
+
+// SearchLocalities ranks the localities against the query: exact matches first, then prefixes, then fuzzy matches
+// Ties prefer a match on the locality over its province or country. The repository prefilters on the first letter
+// of the query, so only those candidates are ranked and a typo in the first letter is not forgiven
+// SearchLocalities ordena las localidades contra la consulta: primero coincidencias exactas, luego prefijos y luego aproximadas
+// Los empates prefieren una coincidencia en la localidad sobre su provincia o país. El repositorio prefiltra por la primera letra
+// de la consulta, así solo se ordenan esos candidatos y un error de tipeo en la primera letra no se tolera
+func (s *GeographyServiceImpl) SearchLocalities(ctx context.Context, query string, limit int) ([]models.LocalityMatch, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.SearchLocalities")
+	defer span.End()
+
+	normalizedQuery := tools.NormalizeName(query)
+	if normalizedQuery == "" {
+		return nil, fmt.Errorf("%w: search query is required", error_message.ErrInvalidInput)
+	}
+
+	localities, err := s.geographyRepository.SearchLocalities(ctx, string([]rune(normalizedQuery)[:1]))
+	if err != nil {
+		return nil, err
+	}
+
+	type rankedMatch struct {
+		match models.LocalityMatch
+		rank  int
+		level int
+	}
+
+	ranked := []rankedMatch{}
+	for _, locality := range localities {
+		levels := []struct {
+			name, level string
+		}{
+			{locality.LocalityName, models.GeographyLevelLocality},
+			{locality.ProvinceName, models.GeographyLevelProvince},
+			{locality.CountryName, models.GeographyLevelCountry},
+		}
+
+		var best *rankedMatch
+		for i, level := range levels {
+			rank, distance, ok := matchName(tools.NormalizeName(level.name), normalizedQuery)
+			if !ok {
+				continue
+			}
+			if best == nil || rank < best.rank || (rank == best.rank && distance < best.match.Distance) {
+				best = &rankedMatch{match: models.LocalityMatch{Locality: locality, MatchedOn: level.level, Distance: distance}, rank: rank, level: i}
+			}
+		}
+		if best != nil {
+			ranked = append(ranked, *best)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if a.match.Distance != b.match.Distance {
+			return a.match.Distance < b.match.Distance
+		}
+		if a.level != b.level {
+			return a.level < b.level
+		}
+		return tools.NormalizeName(a.match.Locality.LocalityName) < tools.NormalizeName(b.match.Locality.LocalityName)
+	})
+
+	matches := []models.LocalityMatch{}
+	for i := 0; i < len(ranked) && i < limit; i++ {
+		matches = append(matches, ranked[i].match)
+	}
+	return matches, nil
+}
+
+// Match ranks used by SearchLocalities / Rangos de coincidencia usados por SearchLocalities
+const (
+	matchRankExact = iota
+	matchRankPrefix
+	matchRankFuzzy
+)
+
+// matchName compares a normalized name against a normalized query, trying the whole name and then each of its words
+// Fuzzy matching compares the query with prefixes one letter shorter, equal or longer and needs at least 3 letters; longer queries tolerate 2 edits
+// matchName compara un nombre normalizado con una consulta normalizada, probando el nombre completo y luego cada una de sus palabras
+// La coincidencia aproximada compara la consulta con prefijos de una letra menos, igual o una más y necesita al menos 3 letras; las consultas más largas toleran 2 ediciones
+func matchName(name, query string) (rank int, distance int, ok bool) {
+	if name == query {
+		return matchRankExact, 0, true
+	}
+
+	candidates := append([]string{name}, strings.Fields(name)...)
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, query) {
+			return matchRankPrefix, 0, true
+		}
+	}
+
+	queryLength := len([]rune(query))
+	if queryLength < 3 {
+		return 0, 0, false
+	}
+	maxDistance := 1
+	if queryLength > 5 {
+		maxDistance = 2
+	}
+
+	best := maxDistance + 1
+	for _, candidate := range candidates {
+		runes := []rune(candidate)
+		for length := queryLength - 1; length <= queryLength+1; length++ {
+			best = min(best, tools.LevenshteinDistance(string(runes[:min(length, len(runes))]), query))
+		}
+	}
+	if best > maxDistance {
+		return 0, 0, false
+	}
+	return matchRankFuzzy, best, true
+}
+
+// GetTree retrieves the whole geographic hierarchy / GetTree recupera toda la jerarquía geográfica
+func (s *GeographyServiceImpl) GetTree(ctx context.Context) ([]models.CountryNode, error) {
+	ctx, span := tracing.Start(ctx, "services.GeographyServiceImpl.GetTree")
+	defer span.End()
+
+	return s.geographyRepository.GetTree(ctx)
+}
+
+// checkMergeIds rejects merging a row into itself / checkMergeIds rechaza fusionar una fila consigo misma
+func checkMergeIds(sourceId, targetId int) error {
+	if sourceId == targetId {
+		return fmt.Errorf("%w: cannot merge %d into itself", error_message.ErrInvalidInput, sourceId)
+	}
+	return nil
+}
+
+// dependencyError reports a missing referenced row as ErrDependencyNotFound, leaving other errors untouched
+// dependencyError reporta una fila referenciada inexistente como ErrDependencyNotFound, dejando los demás errores intactos
+func dependencyError(err error, entity string, id int) error {
+	if errors.Is(err, error_message.ErrNotFound) {
+		return fmt.Errorf("%w: %s with id %d", error_message.ErrDependencyNotFound, entity, id)
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories/memory"
+)
+
+// TestMatchName covers the exact, prefix and fuzzy ranks and the edit budget by query length
+// TestMatchName cubre los rangos exacto, prefijo y aproximado y el límite de ediciones según el largo de la consulta
+func TestMatchName(t *testing.T) {
+	cases := []struct {
+		name, query string
+		rank        int
+		distance    int
+		ok          bool
+	}{
+		{"cordoba", "cordoba", matchRankExact, 0, true},
+		{"cordoba", "cor", matchRankPrefix, 0, true},
+		{"san martin", "mar", matchRankPrefix, 0, true},
+		{"cordoba", "cordova", matchRankFuzzy, 1, true},
+		{"cordoba", "crdoba", matchRankFuzzy, 1, true},
+		{"buenos aires", "aeres", matchRankFuzzy, 1, true},
+		{"buenos aires", "aries", 0, 0, false},
+		{"mendoza", "mendzoa", matchRankFuzzy, 2, true},
+		{"cordoba", "cx", 0, 0, false},
+		{"cordoba", "cxrxoxa", 0, 0, false},
+		{"rosario", "salta", 0, 0, false},
+	}
+
+	for _, tc := range cases {
+		rank, distance, ok := matchName(tc.name, tc.query)
+		if ok != tc.ok || (ok && (rank != tc.rank || distance != tc.distance)) {
+			t.Errorf("matchName(%q, %q) = (%d, %d, %v), expected (%d, %d, %v)", tc.name, tc.query, rank, distance, ok, tc.rank, tc.distance, tc.ok)
+		}
+	}
+}
+
+// TestSearchLocalities ranks the prefiltered candidates of the in-memory repository
+// TestSearchLocalities ordena los candidatos prefiltrados del repositorio en memoria
+func TestSearchLocalities(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	localities := memory.NewLocalityRepository(store)
+	for _, l := range []models.Locality{
+		{LocalityName: "Córdoba", ProvinceName: "Córdoba", CountryName: "Argentina"},
+		{LocalityName: "Villa  Carlos Paz", ProvinceName: "Córdoba", CountryName: "Argentina"},
+		{LocalityName: "San Martín", ProvinceName: "Mendoza", CountryName: "Argentina"},
+		{LocalityName: "Rosario", ProvinceName: "Santa Fe", CountryName: "Argentina"},
+	} {
+		if _, err := localities.Save(ctx, l); err != nil {
+			t.Fatalf("saving %s: %v", l.LocalityName, err)
+		}
+	}
+	service := NewGeographyService(memory.NewGeographyRepository(store))
+
+	cases := []struct {
+		query    string
+		expected []string
+	}{
+		{"cordoba", []string{"Córdoba", "Villa  Carlos Paz"}},
+		{"carlos", []string{"Villa  Carlos Paz"}},
+		{"martn", []string{"San Martín"}},
+		{"zzz", nil},
+	}
+	for _, tc := range cases {
+		matches, err := service.SearchLocalities(ctx, tc.query, 10)
+		if err != nil {
+			t.Fatalf("searching %q: %v", tc.query, err)
+		}
+		var names []string
+		for _, match := range matches {
+			names = append(names, match.Locality.LocalityName)
+		}
+		if !slices.Equal(names, tc.expected) {
+			t.Errorf("SearchLocalities(%q) = %v, expected %v", tc.query, names, tc.expected)
+		}
+	}
+}
@@ -6,21 +6,16 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var inboundOrdersServiceInstance InboundOrdersServiceI
-
-// GetInboundOrdersService - Creates and returns a new instance of InboundOrdersService with required repositories using singleton pattern
-// GetInboundOrdersService - Crea y retorna una nueva instancia de InboundOrdersService con los repositorios requeridos usando patrón singleton
+// GetInboundOrdersService - Creates and returns a new instance of InboundOrdersService with required repositories
+// GetInboundOrdersService - Crea y retorna una nueva instancia de InboundOrdersService con los repositorios requeridos
 func GetInboundOrdersService(inboundOrderRepository repositories.InboundOrderRepositoryI, employeeRepository repositories.EmployeeRepositoryI) InboundOrdersServiceI {
-	if inboundOrdersServiceInstance != nil {
-		return inboundOrdersServiceInstance
-	}
-	inboundOrdersServiceInstance = &InboundOrdersService{
+	return &InboundOrdersService{
 		InboundOrderRepository: inboundOrderRepository,
 		EmployeeRepository:     employeeRepository,
 	}
-	return inboundOrdersServiceInstance
 }
 
 // InboundOrdersServiceI - Interface defining the contract for inbound order service operations with business logic
@@ -49,18 +44,27 @@ type InboundOrdersService struct {
 // GetAllInboundOrdersReports - Delegates retrieving all inbound order reports to the repository
 // GetAllInboundOrdersReports - Delega la obtención de todos los reportes de órdenes de entrada al repositorio
 func (s *InboundOrdersService) GetAllInboundOrdersReports(ctx context.Context) ([]models.InboundOrderReport, error) {
+	ctx, span := tracing.Start(ctx, "services.InboundOrdersService.GetAllInboundOrdersReports")
+	defer span.End()
+
 	return s.InboundOrderRepository.GetAllInboundOrdersReports(ctx)
 }
 
 // GetInboundOrdersReportByEmployeeId - Delegates retrieving an inbound order report by employee ID to the repository
 // GetInboundOrdersReportByEmployeeId - Delega la obtención de un reporte de órdenes de entrada por ID de empleado al repositorio
 func (s *InboundOrdersService) GetInboundOrdersReportByEmployeeId(ctx context.Context, id int) (models.InboundOrderReport, error) {
+	ctx, span := tracing.Start(ctx, "services.InboundOrdersService.GetInboundOrdersReportByEmployeeId")
+	defer span.End()
+
 	return s.InboundOrderRepository.GetInboundOrdersReportByEmployeeId(ctx, id)
 }
 
 // Create - Creates a new inbound order with comprehensive business validation
 // Create - Crea una nueva orden de entrada con validación integral de negocio
 func (s *InboundOrdersService) Create(ctx context.Context, order models.InboundOrder) (models.InboundOrder, error) {
+	ctx, span := tracing.Start(ctx, "services.InboundOrdersService.Create")
+	defer span.End()
+
 	// Business validation: Validate all required fields are provided
 	// Validación de negocio: Validar que todos los campos requeridos estén proporcionados
 	if order.OrderNumber == "" || order.EmployeeId == 0 || order.ProductBatchId == 0 || order.WarehouseId == 0 || order.OrderDate.IsZero() {
@@ -2,22 +2,19 @@ package services
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/responses"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var localityServiceInstance LocalityService
-
-// NewSQLLocalityService - Creates and returns a new instance of SQLLocalityService with the required repository using singleton pattern
-// NewSQLLocalityService - Crea y retorna una nueva instancia de SQLLocalityService con el repositorio requerido usando patrón singleton
+// NewSQLLocalityService - Creates and returns a new instance of SQLLocalityService with the required repository
+// NewSQLLocalityService - Crea y retorna una nueva instancia de SQLLocalityService con el repositorio requerido
 func NewSQLLocalityService(repo repositories.LocalityRepository) LocalityService {
-	if localityServiceInstance != nil {
-		return localityServiceInstance
-	}
-	localityServiceInstance = &SQLLocalityService{repo: repo}
-	return localityServiceInstance
+	return &SQLLocalityService{repo: repo}
 }
 
 // LocalityService - Interface defining the contract for locality service operations with business logic
@@ -30,6 +27,12 @@ type LocalityService interface {
 	// GetSellerReports - Retrieves seller reports for all localities or a specific locality by ID
 	// GetSellerReports - Obtiene reportes de vendedores para todas las localidades o una localidad específica por ID
 	GetSellerReports(ctx context.Context, id int) ([]responses.LocalitySellerReport, error)
+
+	// GetDashboard - Retrieves sellers, carriers and warehouses counts per locality, province or country
+	// An id other than 0 restricts the report to that row, returning ErrNotFound if it doesn't exist
+	// GetDashboard - Obtiene los conteos de vendedores, transportistas y almacenes por localidad, provincia o país
+	// Un id distinto de 0 restringe el reporte a esa fila, retornando ErrNotFound si no existe
+	GetDashboard(ctx context.Context, level string, id int) ([]models.LocalityDashboard, error)
 }
 
 // SQLLocalityService - Implementation of LocalityService containing business logic for locality operations
@@ -41,11 +44,90 @@ type SQLLocalityService struct {
 // Save - Delegates saving a locality to the repository
 // Save - Delega el guardado de una localidad al repositorio
 func (s *SQLLocalityService) Save(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	ctx, span := tracing.Start(ctx, "services.SQLLocalityService.Save")
+	defer span.End()
+
 	return s.repo.Save(ctx, locality)
 }
 
-// GetSellerReports - Delegates retrieving seller reports to the repository
-// GetSellerReports - Delega la obtención de reportes de vendedores al repositorio
+// GetSellerReports - Projects the locality dashboard onto the seller counts
+// GetSellerReports - Proyecta el tablero de localidades sobre los conteos de vendedores
 func (s *SQLLocalityService) GetSellerReports(ctx context.Context, id int) ([]responses.LocalitySellerReport, error) {
-	return s.repo.GetSellerReports(ctx, id)
+	ctx, span := tracing.Start(ctx, "services.SQLLocalityService.GetSellerReports")
+	defer span.End()
+
+	dashboard, err := s.GetDashboard(ctx, models.GeographyLevelLocality, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]responses.LocalitySellerReport, 0, len(dashboard))
+	for _, row := range dashboard {
+		reports = append(reports, responses.LocalitySellerReport{LocalityID: row.Id, LocalityName: row.Name, SellerCount: row.Sellers})
+	}
+	return reports, nil
+}
+
+// GetDashboard - Loads the per locality counts, filtered by the repository, and rolls them up to the requested level
+// GetDashboard - Carga los conteos por localidad, filtrados por el repositorio, y los acumula al nivel solicitado
+func (s *SQLLocalityService) GetDashboard(ctx context.Context, level string, id int) ([]models.LocalityDashboard, error) {
+	ctx, span := tracing.Start(ctx, "services.SQLLocalityService.GetDashboard")
+	defer span.End()
+
+	return localityDashboard(ctx, s.repo, level, id)
+}
+
+// localityDashboard - Builds the dashboard rows of a level, keeping the order of the hierarchy
+// Shared by the locality and carry services so every locality report comes from the same counts
+// localityDashboard - Construye las filas del tablero de un nivel, manteniendo el orden de la jerarquía
+// Compartido por los servicios de localidades y transportistas para que todos los reportes salgan de los mismos conteos
+func localityDashboard(ctx context.Context, repo repositories.LocalityRepository, level string, id int) ([]models.LocalityDashboard, error) {
+	if level != models.GeographyLevelLocality && level != models.GeographyLevelProvince && level != models.GeographyLevelCountry {
+		return nil, fmt.Errorf("%w: level must be locality, province or country", error_message.ErrInvalidInput)
+	}
+
+	counts, err := repo.GetLocalityCounts(ctx, level, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := []models.LocalityDashboard{}
+	index := map[int]int{} // Row id to position in dashboard / Id de la fila a su posición en el tablero
+	for _, c := range counts {
+		key := models.LocalityDashboard{Level: level}
+		switch level {
+		case models.GeographyLevelLocality:
+			key.Id, key.Name = c.LocalityId, c.LocalityName
+			key.ProvinceId, key.ProvinceName = c.ProvinceId, c.ProvinceName
+			key.CountryId, key.CountryName = c.CountryId, c.CountryName
+		case models.GeographyLevelProvince:
+			key.Id, key.Name = c.ProvinceId, c.ProvinceName
+			key.CountryId, key.CountryName = c.CountryId, c.CountryName
+		case models.GeographyLevelCountry:
+			key.Id, key.Name = c.CountryId, c.CountryName
+		}
+
+		// Skip placeholders of empty parents / Omitir marcadores de padres vacíos
+		if key.Id == 0 {
+			continue
+		}
+
+		position, ok := index[key.Id]
+		if !ok {
+			position = len(dashboard)
+			index[key.Id] = position
+			dashboard = append(dashboard, key)
+		}
+		if c.LocalityId != 0 {
+			dashboard[position].Localities++
+		}
+		dashboard[position].Sellers += c.Sellers
+		dashboard[position].Carriers += c.Carriers
+		dashboard[position].Warehouses += c.Warehouses
+	}
+
+	if id != 0 && len(dashboard) == 0 {
+		return nil, fmt.Errorf("%w: %s with id %d", error_message.ErrNotFound, level, id)
+	}
+	return dashboard, nil
 }
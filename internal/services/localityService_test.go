@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories/memory"
+)
+
+// TestGetDashboard rolls the filtered counts up to each level and reports unknown ids
+// TestGetDashboard acumula los conteos filtrados en cada nivel y reporta los ids inexistentes
+func TestGetDashboard(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	localities := memory.NewLocalityRepository(store)
+	sellers := memory.NewSellerRepository(store)
+
+	ids := map[string]int{}
+	for _, l := range []models.Locality{
+		{LocalityName: "San Martín", ProvinceName: "Mendoza", CountryName: "Argentina"},
+		{LocalityName: "Godoy Cruz", ProvinceName: "Mendoza", CountryName: "Argentina"},
+		{LocalityName: "San Martín", ProvinceName: "San Juan", CountryName: "Argentina"},
+	} {
+		saved, err := localities.Save(ctx, l)
+		if err != nil {
+			t.Fatalf("saving %s: %v", l.LocalityName, err)
+		}
+		ids[l.ProvinceName+"/"+l.LocalityName] = saved.Id
+		ids[l.ProvinceName] = saved.ProvinceId
+	}
+	for i, locality := range []string{"Mendoza/San Martín", "Mendoza/Godoy Cruz", "San Juan/San Martín", "San Juan/San Martín"} {
+		if _, err := sellers.Save(ctx, models.Seller{CID: string(rune('A' + i)), LocalityID: ids[locality]}); err != nil {
+			t.Fatalf("saving seller: %v", err)
+		}
+	}
+	service := NewSQLLocalityService(localities)
+
+	cases := []struct {
+		level      string
+		id         int
+		rows       int
+		localities int
+		sellers    int
+	}{
+		{models.GeographyLevelLocality, 0, 3, 3, 4},
+		{models.GeographyLevelLocality, ids["San Juan/San Martín"], 1, 1, 2},
+		{models.GeographyLevelProvince, ids["Mendoza"], 1, 2, 2},
+		{models.GeographyLevelCountry, 0, 1, 3, 4},
+	}
+	for _, tc := range cases {
+		dashboard, err := service.GetDashboard(ctx, tc.level, tc.id)
+		if err != nil {
+			t.Fatalf("GetDashboard(%s, %d): %v", tc.level, tc.id, err)
+		}
+		localityCount, sellerCount := 0, 0
+		for _, row := range dashboard {
+			if tc.id != 0 && row.Id != tc.id {
+				t.Errorf("GetDashboard(%s, %d) returned row %d", tc.level, tc.id, row.Id)
+			}
+			localityCount += row.Localities
+			sellerCount += row.Sellers
+		}
+		if len(dashboard) != tc.rows || localityCount != tc.localities || sellerCount != tc.sellers {
+			t.Errorf("GetDashboard(%s, %d) = %d rows, %d localities, %d sellers, expected %d, %d, %d",
+				tc.level, tc.id, len(dashboard), localityCount, sellerCount, tc.rows, tc.localities, tc.sellers)
+		}
+	}
+
+	if _, err := service.GetDashboard(ctx, models.GeographyLevelProvince, 404); !errors.Is(err, error_message.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown province, got %v", err)
+	}
+}
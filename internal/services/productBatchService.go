@@ -2,24 +2,21 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var productBatchServiceInstance ProductBatchServiceI
-
-// GetProductBatchService - Creates and returns a new instance of productBatchService with the required repository using singleton pattern
-// GetProductBatchService - Crea y retorna una nueva instancia de productBatchService con el repositorio requerido usando patrón singleton
+// GetProductBatchService - Creates and returns a new instance of productBatchService with the required repository
+// GetProductBatchService - Crea y retorna una nueva instancia de productBatchService con el repositorio requerido
 func GetProductBatchService(repository repositories.ProductBatchRepositoryI) ProductBatchServiceI {
-	if productBatchServiceInstance != nil {
-		return productBatchServiceInstance
-	}
-
-	productBatchServiceInstance = &productBatchService{
+	return &productBatchService{
 		repository: repository,
 	}
-	return productBatchServiceInstance
 }
 
 // ProductBatchServiceI - Interface defining the contract for product batch service operations with business logic
@@ -36,6 +33,14 @@ type ProductBatchServiceI interface {
 	// ExistsWithBatchNumber - Checks if a product batch exists with the given batch number, excluding a specific ID
 	// ExistsWithBatchNumber - Verifica si existe un lote de producto con el número de lote dado, excluyendo un ID específico
 	ExistsWithBatchNumber(ctx context.Context, id int, batchNumber string) bool
+
+	// ValidatePlacement - Checks that quantity units of the product fit in the volume and weight limits of the section
+	// ValidatePlacement - Verifica que quantity unidades del producto entren en los límites de volumen y peso de la sección
+	ValidatePlacement(ctx context.Context, section *models.Section, product models.Product, quantity int) error
+
+	// CountDueBefore - Counts the batches with stock left whose due date is before the given time
+	// CountDueBefore - Cuenta los lotes con stock restante cuya fecha de vencimiento es anterior al momento dado
+	CountDueBefore(ctx context.Context, before time.Time) (int, error)
 }
 
 // productBatchService - Implementation of ProductBatchServiceI containing business logic for product batch operations
@@ -47,17 +52,64 @@ type productBatchService struct {
 // Create - Delegates creating a product batch to the repository
 // Create - Delega la creación de un lote de producto al repositorio
 func (s *productBatchService) Create(ctx context.Context, model *models.ProductBatch) error {
+	ctx, span := tracing.Start(ctx, "services.productBatchService.Create")
+	defer span.End()
+
 	return s.repository.Create(ctx, model)
 }
 
 // GetProductQuantityBySectionId - Delegates retrieving product quantity by section ID to the repository
 // GetProductQuantityBySectionId - Delega la obtención de cantidad de producto por ID de sección al repositorio
 func (s *productBatchService) GetProductQuantityBySectionId(ctx context.Context, id int) int {
+	ctx, span := tracing.Start(ctx, "services.productBatchService.GetProductQuantityBySectionId")
+	defer span.End()
+
 	return s.repository.GetProductQuantityBySectionId(ctx, id)
 }
 
 // ExistsWithBatchNumber - Delegates checking product batch existence by batch number to the repository
 // ExistsWithBatchNumber - Delega la verificación de existencia de lote de producto por número de lote al repositorio
 func (s *productBatchService) ExistsWithBatchNumber(ctx context.Context, id int, batchNumber string) bool {
+	ctx, span := tracing.Start(ctx, "services.productBatchService.ExistsWithBatchNumber")
+	defer span.End()
+
 	return s.repository.ExistsWithBatchNumber(ctx, id, batchNumber)
 }
+
+// ValidatePlacement - Adds the new batch to the current occupancy and compares it with the section limits
+// Sections without a volume or weight limit accept any amount of that dimension
+// ValidatePlacement - Suma el nuevo lote a la ocupación actual y la compara con los límites de la sección
+// Las secciones sin límite de volumen o de peso aceptan cualquier cantidad de esa dimensión
+func (s *productBatchService) ValidatePlacement(ctx context.Context, section *models.Section, product models.Product, quantity int) error {
+	ctx, span := tracing.Start(ctx, "services.productBatchService.ValidatePlacement")
+	defer span.End()
+
+	if section.MaximumVolume == nil && section.MaximumWeight == nil {
+		return nil
+	}
+
+	occupancy, err := s.repository.GetSectionOccupancy(ctx, section.Id)
+	if err != nil {
+		return err
+	}
+	occupancy = occupancy.Add(product, quantity)
+
+	if section.MaximumVolume != nil && occupancy.Volume > *section.MaximumVolume {
+		return fmt.Errorf("%w: section %s would hold %.4f m³ of a maximum of %.4f m³",
+			error_message.ErrInvalidInput, section.SectionNumber, occupancy.Volume, *section.MaximumVolume)
+	}
+	if section.MaximumWeight != nil && occupancy.Weight > *section.MaximumWeight {
+		return fmt.Errorf("%w: section %s would hold %.2f kg of a maximum of %.2f kg",
+			error_message.ErrInvalidInput, section.SectionNumber, occupancy.Weight, *section.MaximumWeight)
+	}
+	return nil
+}
+
+// CountDueBefore - Counts the batches with stock left whose due date is before the given time
+// CountDueBefore - Cuenta los lotes con stock restante cuya fecha de vencimiento es anterior al momento dado
+func (s *productBatchService) CountDueBefore(ctx context.Context, before time.Time) (int, error) {
+	ctx, span := tracing.Start(ctx, "services.productBatchService.CountDueBefore")
+	defer span.End()
+
+	return s.repository.CountDueBefore(ctx, before)
+}
@@ -7,18 +7,13 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var productRecordServiceInstance ProductRecordServiceI
-
 // NewProductRecordService - Función constructora que crea una nueva instancia del servicio con inyección de dependencias
 // NewProductRecordService - Constructor function that creates a new service instance with dependency injection
 func NewProductRecordService(repository repositories.IProductRecordRepository, ProductService ProductService) ProductRecordServiceI {
-	if productRecordServiceInstance != nil {
-		return productRecordServiceInstance
-	}
-	productRecordServiceInstance = &productRecordService{Repository: repository, ProductService: ProductService}
-	return productRecordServiceInstance
+	return &productRecordService{Repository: repository, ProductService: ProductService}
 }
 
 // ProductRecordServiceI - Interfaz que define el contrato para la lógica de negocio de registros de productos
@@ -51,6 +46,9 @@ type productRecordService struct {
 // CreateProductRecord - Lógica de negocio para crear registros de productos con validación
 // CreateProductRecord - Business logic for creating product records with validation
 func (prs *productRecordService) CreateProductRecord(ctx context.Context, productRecord models.ProductRecord) (*models.ProductRecord, error) {
+	ctx, span := tracing.Start(ctx, "services.productRecordService.CreateProductRecord")
+	defer span.End()
+
 	// VALIDACIÓN DE NEGOCIO: Verificar si el producto referenciado existe antes de crear un registro
 	// BUSINESS VALIDATION: Check if the referenced product exists before creating a record
 	exist, err := prs.ProductService.ExistById(ctx, productRecord.ProductID)
@@ -75,6 +73,9 @@ func (prs *productRecordService) CreateProductRecord(ctx context.Context, produc
 // GetReportByIdProduct - Lógica de negocio para generar reportes de productos con validación
 // GetReportByIdProduct - Business logic for generating product reports with validation
 func (prs *productRecordService) GetReportByIdProduct(ctx context.Context, id int64) (*models.ProductRecordReport, error) {
+	ctx, span := tracing.Start(ctx, "services.productRecordService.GetReportByIdProduct")
+	defer span.End()
+
 	// VALIDACIÓN DE NEGOCIO: Verificar que el producto existe antes de generar el reporte
 	// BUSINESS VALIDATION: Verify product exists before generating report
 	exist, err := prs.ProductService.ExistById(ctx, id)
@@ -99,11 +100,17 @@ func (prs *productRecordService) GetReportByIdProduct(ctx context.Context, id in
 // GetReport - Obtiene el reporte completo de todos los registros de productos
 // GetReport - Retrieves the complete report of all product records
 func (prs *productRecordService) GetReport(ctx context.Context) ([]*models.ProductRecordReport, error) {
+	ctx, span := tracing.Start(ctx, "services.productRecordService.GetReport")
+	defer span.End()
+
 	return prs.Repository.GetReport(ctx)
 }
 
 // ExistProductRecordByID - Verifica si existe un registro de producto por su ID
 // ExistProductRecordByID - Checks if a product record exists by its ID
 func (prs *productRecordService) ExistProductRecordByID(ctx context.Context, id int64) bool {
+	ctx, span := tracing.Start(ctx, "services.productRecordService.ExistProductRecordByID")
+	defer span.End()
+
 	return prs.Repository.ExistProductRecordByID(ctx, id)
 }
@@ -5,20 +5,15 @@ import (
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var productServiceInstance ProductService
-
 // NewProductService crea una nueva instancia del servicio de productos con inyección de dependencias
 // NewProductService creates a new instance of the product service with dependency injection
 func NewProductService(r repositories.ProductRepository) ProductService {
-	if productServiceInstance != nil {
-		return productServiceInstance
-	}
-	productServiceInstance = &service{
+	return &service{
 		repository: r,
 	}
-	return productServiceInstance
 }
 
 // ProductService define la interfaz para la lógica de negocio de productos
@@ -62,41 +57,62 @@ type service struct {
 // GetAll delega la obtención de todos los productos al repositorio
 // GetAll delegates retrieving all products to the repository
 func (s *service) GetAll(ctx context.Context) ([]models.Product, error) {
+	ctx, span := tracing.Start(ctx, "services.service.GetAll")
+	defer span.End()
+
 	return s.repository.GetAll(ctx)
 }
 
 // GetByID delega la obtención de un producto por ID al repositorio
 // GetByID delegates retrieving a product by ID to the repository
 func (s *service) GetByID(ctx context.Context, id int64) (models.Product, error) {
+	ctx, span := tracing.Start(ctx, "services.service.GetByID")
+	defer span.End()
+
 	return s.repository.GetByID(ctx, id)
 }
 
 // Create delega la creación de un nuevo producto al repositorio
 // Create delegates creating a new product to the repository
 func (s *service) Create(ctx context.Context, newProduct models.Product) (models.Product, error) {
+	ctx, span := tracing.Start(ctx, "services.service.Create")
+	defer span.End()
+
 	return s.repository.Create(ctx, newProduct)
 }
 
 // CreateByBatch delega la creación de múltiples productos en lote al repositorio
 // CreateByBatch delegates creating multiple products in batch to the repository
 func (s *service) CreateByBatch(ctx context.Context, products []models.Product) ([]models.Product, error) {
+	ctx, span := tracing.Start(ctx, "services.service.CreateByBatch")
+	defer span.End()
+
 	return s.repository.CreateByBatch(ctx, products)
 }
 
 // Update delega la actualización de un producto al repositorio
 // Update delegates updating a product to the repository
 func (s *service) Update(ctx context.Context, id int64, updateProduct models.Product) (models.Product, error) {
+	ctx, span := tracing.Start(ctx, "services.service.Update")
+	defer span.End()
+
 	return s.repository.Update(ctx, id, updateProduct)
 }
 
 // Delete delega la eliminación de un producto al repositorio
 // Delete delegates deleting a product to the repository
 func (s *service) Delete(ctx context.Context, id int64) error {
+	ctx, span := tracing.Start(ctx, "services.service.Delete")
+	defer span.End()
+
 	return s.repository.Delete(ctx, id)
 }
 
 // ExistById delega la verificación de existencia de un producto al repositorio
 // ExistById delegates checking product existence to the repository
 func (s *service) ExistById(ctx context.Context, id int64) (bool, error) {
+	ctx, span := tracing.Start(ctx, "services.service.ExistById")
+	defer span.End()
+
 	return s.repository.Exists(ctx, id)
 }
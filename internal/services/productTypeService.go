@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
+)
+
+// NewProductTypeService creates and returns a new instance of ProductTypeServiceImpl with the required repository
+// NewProductTypeService crea y retorna una nueva instancia de ProductTypeServiceImpl con el repositorio requerido
+func NewProductTypeService(productTypeRepository repositories.ProductTypeRepository) ProductTypeService {
+	return &ProductTypeServiceImpl{productTypeRepository: productTypeRepository}
+}
+
+// ProductTypeService defines the contract for product type operations and storage requirement checks
+// ProductTypeService define el contrato para las operaciones de tipos de producto y verificaciones de requisitos de almacenamiento
+type ProductTypeService interface {
+	GetAll(ctx context.Context) ([]models.ProductType, error)
+	GetById(ctx context.Context, id int) (models.ProductType, error)
+	Create(ctx context.Context, productType models.ProductType) (models.ProductType, error)
+	Update(ctx context.Context, id int, productType models.ProductType) (models.ProductType, error)
+	Delete(ctx context.Context, id int) error
+
+	// ValidateStorage checks that the product type exists and accepts the given temperature
+	// Returns ErrDependencyNotFound if the type doesn't exist and ErrInvalidInput if the temperature is out of range
+	// ValidateStorage verifica que el tipo de producto exista y acepte la temperatura dada
+	// Retorna ErrDependencyNotFound si el tipo no existe y ErrInvalidInput si la temperatura está fuera de rango
+	ValidateStorage(ctx context.Context, id int, temperature float64) error
+}
+
+// ProductTypeServiceImpl implements ProductTypeService and contains business logic for product types
+// ProductTypeServiceImpl implementa ProductTypeService y contiene la lógica de negocio de tipos de producto
+type ProductTypeServiceImpl struct {
+	productTypeRepository repositories.ProductTypeRepository // Repository for product type data access / Repositorio para acceso a datos de tipos de producto
+}
+
+// GetAll retrieves all product types from the repository
+// GetAll recupera todos los tipos de producto del repositorio
+func (s *ProductTypeServiceImpl) GetAll(ctx context.Context) ([]models.ProductType, error) {
+	ctx, span := tracing.Start(ctx, "services.ProductTypeServiceImpl.GetAll")
+	defer span.End()
+
+	return s.productTypeRepository.GetAll(ctx)
+}
+
+// GetById retrieves a product type by its ID
+// GetById recupera un tipo de producto por su ID
+func (s *ProductTypeServiceImpl) GetById(ctx context.Context, id int) (models.ProductType, error) {
+	ctx, span := tracing.Start(ctx, "services.ProductTypeServiceImpl.GetById")
+	defer span.End()
+
+	return s.productTypeRepository.GetById(ctx, id)
+}
+
+// Create validates and stores a new product type
+// Create valida y guarda un nuevo tipo de producto
+func (s *ProductTypeServiceImpl) Create(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	ctx, span := tracing.Start(ctx, "services.ProductTypeServiceImpl.Create")
+	defer span.End()
+
+	if err := s.validate(ctx, 0, productType); err != nil {
+		return models.ProductType{}, err
+	}
+	return s.productTypeRepository.Create(ctx, productType)
+}
+
+// Update validates and overwrites an existing product type
+// Update valida y sobrescribe un tipo de producto existente
+func (s *ProductTypeServiceImpl) Update(ctx context.Context, id int, productType models.ProductType) (models.ProductType, error) {
+	ctx, span := tracing.Start(ctx, "services.ProductTypeServiceImpl.Update")
+	defer span.End()
+
+	if _, err := s.productTypeRepository.GetById(ctx, id); err != nil {
+		return models.ProductType{}, err
+	}
+	if err := s.validate(ctx, id, productType); err != nil {
+		return models.ProductType{}, err
+	}
+	return s.productTypeRepository.Update(ctx, id, productType)
+}
+
+// Delete removes a product type unless sections or products still reference it
+// Delete elimina un tipo de producto salvo que secciones o productos aún lo referencien
+func (s *ProductTypeServiceImpl) Delete(ctx context.Context, id int) error {
+	ctx, span := tracing.Start(ctx, "services.ProductTypeServiceImpl.Delete")
+	defer span.End()
+
+	if _, err := s.productTypeRepository.GetById(ctx, id); err != nil {
+		return err
+	}
+
+	dependents, err := s.productTypeRepository.CountDependents(ctx, id)
+	if err != nil {
+		return err
+	}
+	if dependents > 0 {
+		return fmt.Errorf("%w: %d sections or products use product type %d", error_message.ErrHasDependents, dependents, id)
+	}
+	return s.productTypeRepository.Delete(ctx, id)
+}
+
+// ValidateStorage checks a storage temperature against the requirements of a product type
+// ValidateStorage verifica una temperatura de almacenamiento contra los requisitos de un tipo de producto
+func (s *ProductTypeServiceImpl) ValidateStorage(ctx context.Context, id int, temperature float64) error {
+	ctx, span := tracing.Start(ctx, "services.ProductTypeServiceImpl.ValidateStorage")
+	defer span.End()
+
+	productType, err := s.productTypeRepository.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, error_message.ErrNotFound) {
+			return fmt.Errorf("%w: product type with id %d", error_message.ErrDependencyNotFound, id)
+		}
+		return err
+	}
+
+	if !productType.AcceptsTemperature(temperature) {
+		return fmt.Errorf("%w: temperature %.2f is outside the %s range [%.2f, %.2f] of product type %d",
+			error_message.ErrInvalidInput, temperature, productType.StorageClass,
+			productType.MinimumTemperature, productType.MaximumTemperature, id)
+	}
+	return nil
+}
+
+// validate enforces a coherent temperature range and a unique description
+// validate exige un rango de temperatura coherente y una descripción única
+func (s *ProductTypeServiceImpl) validate(ctx context.Context, id int, productType models.ProductType) error {
+	if productType.MinimumTemperature > productType.MaximumTemperature {
+		return fmt.Errorf("%w: minimum_temperature must not be greater than maximum_temperature", error_message.ErrInvalidInput)
+	}
+
+	exists, err := s.productTypeRepository.ExistsByDescription(ctx, productType.Description, id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("%w: product type with description %s", error_message.ErrAlreadyExists, productType.Description)
+	}
+	return nil
+}
@@ -7,22 +7,17 @@ import (
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var purchaseOrderServiceInstance PurchaseOrderServiceI
-
-// GetPurchaseOrderService creates and returns a singleton instance of PurchaseOrderService with the required repositories
-// GetPurchaseOrderService crea y retorna una instancia singleton de PurchaseOrderService con los repositorios requeridos
+// GetPurchaseOrderService creates and returns a new instance of PurchaseOrderService with the required repositories
+// GetPurchaseOrderService crea y retorna una nueva instancia de PurchaseOrderService con los repositorios requeridos
 func GetPurchaseOrderService(purchaseOrderRepository repositories.PurchaseOrderRepositoryI, buyerRepository repositories.BuyerRepositoryI, productRecordRepository repositories.IProductRecordRepository) PurchaseOrderServiceI {
-	if purchaseOrderServiceInstance != nil {
-		return purchaseOrderServiceInstance
-	}
-	purchaseOrderServiceInstance = &PurchaseOrderService{
+	return &PurchaseOrderService{
 		PurchaseOrderRepository: purchaseOrderRepository,
 		BuyerRepository:         buyerRepository,
 		ProductRecordRepository: productRecordRepository,
 	}
-	return purchaseOrderServiceInstance
 }
 
 // PurchaseOrderServiceI defines the contract for purchase order service operations with business logic
@@ -44,6 +39,9 @@ type PurchaseOrderService struct {
 // GetAll retrieves all purchase orders from the repository
 // GetAll recupera todas las órdenes de compra del repositorio
 func (s *PurchaseOrderService) GetAll(ctx context.Context) (map[int]models.PurchaseOrder, error) {
+	ctx, span := tracing.Start(ctx, "services.PurchaseOrderService.GetAll")
+	defer span.End()
+
 	return s.PurchaseOrderRepository.GetAll(ctx)
 }
 
@@ -52,6 +50,9 @@ func (s *PurchaseOrderService) GetAll(ctx context.Context) (map[int]models.Purch
 // GetPurchaseOrdersReport recupera reportes de órdenes de compra con filtrado opcional por ID de comprador
 // Si se proporciona id, retorna el reporte para ese comprador específico, de lo contrario retorna reportes para todos los compradores
 func (s *PurchaseOrderService) GetPurchaseOrdersReport(ctx context.Context, id *int) ([]models.PurchaseOrderReport, error) {
+	ctx, span := tracing.Start(ctx, "services.PurchaseOrderService.GetPurchaseOrdersReport")
+	defer span.End()
+
 	if id != nil {
 		// Get report for specific buyer / Obtener reporte para comprador específico
 		reports := []models.PurchaseOrderReport{}
@@ -71,10 +72,13 @@ func (s *PurchaseOrderService) GetPurchaseOrdersReport(ctx context.Context, id *
 // Create crea una nueva orden de compra con validación de negocio comprensiva
 // Valida que el número de orden no exista, que el comprador exista, y que el registro de producto exista
 func (s *PurchaseOrderService) Create(ctx context.Context, order models.PurchaseOrder) (models.PurchaseOrder, error) {
+	ctx, span := tracing.Start(ctx, "services.PurchaseOrderService.Create")
+	defer span.End()
+
 	// Validate that order number doesn't exist / Validar que el número de orden no exista
 	exists, err := s.PurchaseOrderRepository.ExistPurchaseOrderByOrderNumber(ctx, order.OrderNumber)
 	if err != nil {
-		return models.PurchaseOrder{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.PurchaseOrder{}, internalError(ctx, err)
 	}
 	if exists {
 		return models.PurchaseOrder{}, fmt.Errorf("%w. %s %s %s", error_message.ErrAlreadyExists, "Order number ", order.OrderNumber, "already exists.")
@@ -83,7 +87,7 @@ func (s *PurchaseOrderService) Create(ctx context.Context, order models.Purchase
 	// Validate that buyer ID exists / Validar que el ID del comprador exista
 	exists, err = s.BuyerRepository.ExistBuyerById(ctx, order.BuyerId)
 	if err != nil {
-		return models.PurchaseOrder{}, fmt.Errorf("%w - %s", error_message.ErrInternalServerError, err.Error())
+		return models.PurchaseOrder{}, internalError(ctx, err)
 	}
 	if !exists {
 		return models.PurchaseOrder{}, fmt.Errorf("%w. %s %d %s", error_message.ErrNotFound, "Buyer with Id", order.BuyerId, "doesn't exists.")
@@ -2,25 +2,20 @@ package services
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var sectionServiceInstance SectionServiceI
-
-// GetSectionService creates and returns a singleton instance of sectionService with the required repository
-// GetSectionService crea y retorna una instancia singleton de sectionService con el repositorio requerido
+// GetSectionService creates and returns a new instance of sectionService with the required repository
+// GetSectionService crea y retorna una nueva instancia de sectionService con el repositorio requerido
 func GetSectionService(repository repositories.SectionRepositoryI) SectionServiceI {
-	if sectionServiceInstance != nil {
-		return sectionServiceInstance
-	}
-
-	sectionServiceInstance = &sectionService{
+	return &sectionService{
 		repository: repository,
 	}
-	return sectionServiceInstance
 }
 
 // SectionServiceI defines the contract for section service operations with business logic and validation
@@ -30,7 +25,8 @@ type SectionServiceI interface {
 	GetByID(ctx context.Context, id int) (*models.Section, error)
 	Create(ctx context.Context, model *models.Section) error
 	Update(ctx context.Context, model *models.Section) error
-	DeleteByID(ctx context.Context, id int) error
+	DeleteByID(ctx context.Context, id int, force bool) error
+	DeleteImpact(ctx context.Context, id int) (models.DeleteImpact, error)
 	ExistWithID(ctx context.Context, id int) bool
 	ExistsWithSectionNumber(ctx context.Context, id int, sectionNumber string) bool
 }
@@ -44,12 +40,18 @@ type sectionService struct {
 // GetAll retrieves all sections from the repository
 // GetAll recupera todas las secciones del repositorio
 func (s *sectionService) GetAll(ctx context.Context) ([]*models.Section, error) {
+	ctx, span := tracing.Start(ctx, "services.sectionService.GetAll")
+	defer span.End()
+
 	return s.repository.GetAll(ctx)
 }
 
 // GetByID retrieves a section by its ID with error handling for non-existent sections
 // GetByID recupera una sección por su ID con manejo de errores para secciones no existentes
 func (s *sectionService) GetByID(ctx context.Context, id int) (*models.Section, error) {
+	ctx, span := tracing.Start(ctx, "services.sectionService.GetByID")
+	defer span.End()
+
 	if model, err := s.repository.GetByID(ctx, id); err != nil {
 		return model, error_message.ErrNotFound
 	} else {
@@ -60,32 +62,76 @@ func (s *sectionService) GetByID(ctx context.Context, id int) (*models.Section,
 // Create creates a new section in the repository
 // Create crea una nueva sección en el repositorio
 func (s *sectionService) Create(ctx context.Context, model *models.Section) error {
+	ctx, span := tracing.Start(ctx, "services.sectionService.Create")
+	defer span.End()
+
 	return s.repository.Create(ctx, model)
 }
 
 // Update modifies an existing section in the repository
 // Update modifica una sección existente en el repositorio
 func (s *sectionService) Update(ctx context.Context, model *models.Section) error {
+	ctx, span := tracing.Start(ctx, "services.sectionService.Update")
+	defer span.End()
+
 	return s.repository.Update(ctx, model)
 }
 
 // DeleteByID removes a section by its ID with error handling for non-existent sections
+// Refuses to delete when dependent rows exist unless force is true
 // DeleteByID elimina una sección por su ID con manejo de errores para secciones no existentes
-func (s *sectionService) DeleteByID(ctx context.Context, id int) error {
+// Rechaza el borrado cuando existen filas dependientes salvo que force sea true
+func (s *sectionService) DeleteByID(ctx context.Context, id int, force bool) error {
+	ctx, span := tracing.Start(ctx, "services.sectionService.DeleteByID")
+	defer span.End()
+
+	impact, err := s.DeleteImpact(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !force && impact.Total() > 0 {
+		return fmt.Errorf("%w: section with id %d has %d dependent records, use force=true to delete them",
+			error_message.ErrHasDependents, id, impact.Total())
+	}
+
 	if err := s.repository.DeleteByID(ctx, id); err != nil {
 		return error_message.ErrNotFound
 	}
 	return nil
 }
 
+// DeleteImpact returns the dependent rows that deleting the section would remove
+// DeleteImpact retorna las filas dependientes que eliminaría el borrado de la sección
+func (s *sectionService) DeleteImpact(ctx context.Context, id int) (models.DeleteImpact, error) {
+	ctx, span := tracing.Start(ctx, "services.sectionService.DeleteImpact")
+	defer span.End()
+
+	if !s.repository.ExistWithID(ctx, id) {
+		return models.DeleteImpact{}, error_message.ErrNotFound
+	}
+
+	impact, err := s.repository.CountDependents(ctx, id)
+	if err != nil {
+		return models.DeleteImpact{}, internalError(ctx, err)
+	}
+	return impact, nil
+}
+
 // ExistWithID checks if a section exists by its ID
 // ExistWithID verifica si una sección existe por su ID
 func (s *sectionService) ExistWithID(ctx context.Context, id int) bool {
+	ctx, span := tracing.Start(ctx, "services.sectionService.ExistWithID")
+	defer span.End()
+
 	return s.repository.ExistWithID(ctx, id)
 }
 
 // ExistsWithSectionNumber checks if a section number exists, excluding a specific ID for updates
 // ExistsWithSectionNumber verifica si un número de sección existe, excluyendo un ID específico para actualizaciones
 func (s *sectionService) ExistsWithSectionNumber(ctx context.Context, id int, sectionNumber string) bool {
+	ctx, span := tracing.Start(ctx, "services.sectionService.ExistsWithSectionNumber")
+	defer span.End()
+
 	return s.repository.ExistsWithSectionNumber(ctx, id, sectionNumber)
 }
@@ -1,87 +1,170 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var sellerServiceInstance SellerService
-
-// NewJSONSellerService creates and returns a singleton instance of JsonSellerService with the required repository
-// NewJSONSellerService crea y retorna una instancia singleton de JsonSellerService con el repositorio requerido
-func NewJSONSellerService(repo repositories.SellerRepository) SellerService {
-	if sellerServiceInstance != nil {
-		return sellerServiceInstance
-	}
-	sellerServiceInstance = &JsonSellerService{
-		repo: repo,
+// NewJSONSellerService creates and returns a new instance of JsonSellerService with the required repositories
+// NewJSONSellerService crea y retorna una nueva instancia de JsonSellerService con los repositorios requeridos
+func NewJSONSellerService(repo repositories.SellerRepository, localityRepo repositories.LocalityRepository) SellerService {
+	return &JsonSellerService{
+		repo:         repo,
+		localityRepo: localityRepo,
 	}
-	return sellerServiceInstance
 }
 
 // SellerService defines the contract for seller service operations with business logic
 // SellerService define el contrato para las operaciones de servicio de vendedores con lógica de negocio
 type SellerService interface {
-	GetAll() ([]models.Seller, error)
-	GetById(id int) (models.Seller, error)
-	Save(seller models.Seller) ([]models.Seller, error)
-	Update(id int, seller models.Seller) ([]models.Seller, error)
-	Delete(id int) error
+	GetAll(ctx context.Context) ([]models.Seller, error)
+	GetById(ctx context.Context, id int) (models.Seller, error)
+	Save(ctx context.Context, seller models.Seller) (models.Seller, error)
+	Update(ctx context.Context, id int, seller models.Seller) (models.Seller, error)
+	Delete(ctx context.Context, id int) error
+	GetProducts(ctx context.Context, id int) ([]models.Product, error)
+	GetReport(ctx context.Context, id int, from, to *time.Time) (models.SellerReport, error)
 }
 
-// JsonSellerService implements SellerService and contains business logic for seller operations using JSON storage
-// JsonSellerService implementa SellerService y contiene la lógica de negocio para operaciones de vendedores usando almacenamiento JSON
+// JsonSellerService implements SellerService and contains business logic for seller operations
+// JsonSellerService implementa SellerService y contiene la lógica de negocio para operaciones de vendedores
 type JsonSellerService struct {
-	repo repositories.SellerRepository // Repository for seller data access / Repositorio para acceso a datos de vendedores
+	repo         repositories.SellerRepository   // Repository for seller data access / Repositorio para acceso a datos de vendedores
+	localityRepo repositories.LocalityRepository // Repository for locality validation / Repositorio para validación de localidades
 }
 
 // GetAll retrieves all sellers from the repository
 // GetAll recupera todos los vendedores del repositorio
-func (s *JsonSellerService) GetAll() ([]models.Seller, error) {
-	sellers, err := s.repo.GetAll()
-	return sellers, err
+func (s *JsonSellerService) GetAll(ctx context.Context) ([]models.Seller, error) {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.GetAll")
+	defer span.End()
+
+	return s.repo.GetAll(ctx)
+}
+
+// GetById retrieves a seller by its ID, returning ErrNotFound if the seller doesn't exist
+// GetById recupera un vendedor por su ID, retornando ErrNotFound si el vendedor no existe
+func (s *JsonSellerService) GetById(ctx context.Context, id int) (models.Seller, error) {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.GetById")
+	defer span.End()
+
+	return s.repo.GetById(ctx, id)
 }
 
-// GetById retrieves a seller by its ID with business logic to search through all sellers
-// Returns ErrNotFound if the seller doesn't exist
-// GetById recupera un vendedor por su ID con lógica de negocio para buscar entre todos los vendedores
-// Retorna ErrNotFound si el vendedor no existe
-func (s *JsonSellerService) GetById(id int) (models.Seller, error) {
-	// Get all sellers from repository / Obtener todos los vendedores del repositorio
-	sellers, err := s.repo.GetAll()
+// Save creates a new seller after checking CID uniqueness and locality existence
+// Save crea un nuevo vendedor después de verificar la unicidad del CID y la existencia de la localidad
+func (s *JsonSellerService) Save(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.Save")
+	defer span.End()
+
+	if err := s.validateCidUniqueness(ctx, seller.CID); err != nil {
+		return models.Seller{}, err
+	}
+	if err := s.validateLocality(ctx, seller.LocalityID); err != nil {
+		return models.Seller{}, err
+	}
+
+	return s.repo.Save(ctx, seller)
+}
+
+// Update applies the non empty fields of seller over the stored one, keeping the business rules of Save
+// Update aplica los campos no vacíos de seller sobre el almacenado, manteniendo las reglas de negocio de Save
+func (s *JsonSellerService) Update(ctx context.Context, id int, seller models.Seller) (models.Seller, error) {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.Update")
+	defer span.End()
+
+	existing, err := s.repo.GetById(ctx, id)
 	if err != nil {
-		return models.Seller{}, error_message.ErrNotFound
+		return models.Seller{}, err
 	}
 
-	// Search for seller with matching ID / Buscar vendedor con ID coincidente
-	for _, seller := range sellers {
-		if seller.Id == id {
-			return seller, nil
+	// Update only the provided fields (partial update logic) / Actualizar solo los campos proporcionados (lógica de actualización parcial)
+	if seller.CID != "" && seller.CID != existing.CID {
+		if err := s.validateCidUniqueness(ctx, seller.CID); err != nil {
+			return models.Seller{}, err
+		}
+		existing.CID = seller.CID
+	}
+	if seller.CompanyName != "" {
+		existing.CompanyName = seller.CompanyName
+	}
+	if seller.Address != "" {
+		existing.Address = seller.Address
+	}
+	if seller.Telephone != "" {
+		existing.Telephone = seller.Telephone
+	}
+	if seller.LocalityID != 0 && seller.LocalityID != existing.LocalityID {
+		if err := s.validateLocality(ctx, seller.LocalityID); err != nil {
+			return models.Seller{}, err
 		}
+		existing.LocalityID = seller.LocalityID
 	}
 
-	// Return error if seller not found / Retornar error si el vendedor no se encuentra
-	return models.Seller{}, error_message.ErrNotFound
+	return s.repo.Update(ctx, id, existing)
 }
 
-// Save creates a new seller in the repository
-// Save crea un nuevo vendedor en el repositorio
-func (s *JsonSellerService) Save(seller models.Seller) ([]models.Seller, error) {
-	sellerCreated, err := s.repo.Save(seller)
-	return sellerCreated, err
+// Delete removes a seller by ID from the repository
+// Delete elimina un vendedor por ID del repositorio
+func (s *JsonSellerService) Delete(ctx context.Context, id int) error {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.Delete")
+	defer span.End()
+
+	return s.repo.Delete(ctx, id)
 }
 
-// Update modifies an existing seller by ID in the repository
-// Update modifica un vendedor existente por ID en el repositorio
-func (s *JsonSellerService) Update(id int, seller models.Seller) ([]models.Seller, error) {
-	sellerFounded, err := s.repo.Update(id, seller)
-	return sellerFounded, err
+// GetProducts retrieves the catalog of a seller, returning ErrNotFound if the seller doesn't exist
+// GetProducts recupera el catálogo de un vendedor, retornando ErrNotFound si el vendedor no existe
+func (s *JsonSellerService) GetProducts(ctx context.Context, id int) ([]models.Product, error) {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.GetProducts")
+	defer span.End()
+
+	if _, err := s.repo.GetById(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetProducts(ctx, id)
 }
 
-// Delete removes a seller by ID from the repository
-// Delete elimina un vendedor por ID del repositorio
-func (s *JsonSellerService) Delete(id int) error {
-	err := s.repo.Delete(id)
-	return err
+// GetReport builds the seller report for the given date range, both bounds optional
+// GetReport construye el reporte del vendedor para el rango de fechas dado, ambos límites opcionales
+func (s *JsonSellerService) GetReport(ctx context.Context, id int, from, to *time.Time) (models.SellerReport, error) {
+	ctx, span := tracing.Start(ctx, "services.JsonSellerService.GetReport")
+	defer span.End()
+
+	if from != nil && to != nil && from.After(*to) {
+		return models.SellerReport{}, fmt.Errorf("%w: from must not be after to", error_message.ErrInvalidInput)
+	}
+	return s.repo.GetReport(ctx, id, from, to)
+}
+
+// validateCidUniqueness returns ErrAlreadyExists when another seller already uses the CID
+// validateCidUniqueness retorna ErrAlreadyExists cuando otro vendedor ya usa el CID
+func (s *JsonSellerService) validateCidUniqueness(ctx context.Context, cid string) error {
+	exists, err := s.repo.ExistsByCid(ctx, cid)
+	if err != nil {
+		return internalError(ctx, err)
+	}
+	if exists {
+		return fmt.Errorf("%w: seller with cid %s", error_message.ErrAlreadyExists, cid)
+	}
+	return nil
+}
+
+// validateLocality returns ErrDependencyNotFound when the locality doesn't exist
+// validateLocality retorna ErrDependencyNotFound cuando la localidad no existe
+func (s *JsonSellerService) validateLocality(ctx context.Context, localityID int) error {
+	exists, err := s.localityRepo.ExistById(ctx, localityID)
+	if err != nil {
+		return internalError(ctx, err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: locality with id %d", error_message.ErrDependencyNotFound, localityID)
+	}
+	return nil
 }
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/XSAM/otelsql"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// TestCardNumberQuerySpan expects the card number lookup of BuyerService.Create to be traced under the service span
+// TestCardNumberQuerySpan espera que la búsqueda de números de tarjeta de BuyerService.Create quede trazada bajo el span del servicio
+func TestCardNumberQuerySpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	db := otelsql.OpenDB(emptyConnector{}, otelsql.WithTracerProvider(provider))
+	t.Cleanup(func() { db.Close() })
+	service := GetBuyerService(repositories.GetNewBuyerMySQLRepository(db))
+
+	// Only the spans matter, the fake database answers every write with an error
+	// Solo importan los spans, la base falsa responde cada escritura con un error
+	_, _ = service.Create(context.Background(), models.Buyer{CardNumberId: "B-1", FirstName: "Ana", LastName: "Prueba"})
+
+	var serviceSpan, querySpan sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "services.BuyerService.Create" {
+			serviceSpan = span
+		}
+		for _, attr := range span.Attributes() {
+			if strings.Contains(attr.Value.Emit(), "id_card_number from buyers") {
+				querySpan = span
+			}
+		}
+	}
+	if serviceSpan == nil || querySpan == nil {
+		t.Fatalf("expected the service and query spans, got %d spans", len(recorder.Ended()))
+	}
+	if querySpan.Parent().SpanID() != serviceSpan.SpanContext().SpanID() {
+		t.Errorf("expected the card number query to be a child of %s, got parent %s",
+			serviceSpan.SpanContext().SpanID(), querySpan.Parent().SpanID())
+	}
+}
+
+// emptyConnector is a database answering every query with no rows and failing every exec
+// emptyConnector es una base que responde cada consulta sin filas y falla cada exec
+type emptyConnector struct{}
+
+func (emptyConnector) Connect(context.Context) (driver.Conn, error) { return emptyConn{}, nil }
+func (emptyConnector) Driver() driver.Driver                        { return nil }
+
+type emptyConn struct{}
+
+func (emptyConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (emptyConn) Close() error                        { return nil }
+func (emptyConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (emptyConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+func (emptyConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return nil, errors.New("read only")
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return []string{"id_card_number"} }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
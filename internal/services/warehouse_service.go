@@ -3,22 +3,19 @@ package services
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/repositories"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/tracing"
 )
 
-var warehouseServiceInstance WarehouseService
-
-// NewWarehouseService creates and returns a singleton instance of WarehouseServiceImpl with the required repository
-// NewWarehouseService crea y retorna una instancia singleton de WarehouseServiceImpl con el repositorio requerido
+// NewWarehouseService creates and returns a new instance of WarehouseServiceImpl with the required repository
+// NewWarehouseService crea y retorna una nueva instancia de WarehouseServiceImpl con el repositorio requerido
 func NewWarehouseService(warehouseRepository repositories.WarehouseRepository) WarehouseService {
-	if warehouseServiceInstance != nil {
-		return warehouseServiceInstance
-	}
-	warehouseServiceInstance = &WarehouseServiceImpl{warehouseRepository: warehouseRepository}
-	return warehouseServiceInstance
+	return &WarehouseServiceImpl{warehouseRepository: warehouseRepository}
 }
 
 // WarehouseService defines the contract for warehouse service operations with business logic and validation
@@ -28,8 +25,11 @@ type WarehouseService interface {
 	Create(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error)
 	ValidateCodeUniqueness(ctx context.Context, code string) error
 	GetById(ctx context.Context, id int) (models.Warehouse, error)
-	Delete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int, force bool) error
+	DeleteImpact(ctx context.Context, id int) (models.DeleteImpact, error)
 	Update(ctx context.Context, id int, warehouse models.Warehouse) (models.Warehouse, error)
+	GetStock(ctx context.Context, id int) (models.WarehouseStock, error)
+	GetStockTotals(ctx context.Context) ([]models.WarehouseStockTotal, error)
 }
 
 // WarehouseServiceImpl implements WarehouseService and contains business logic for warehouse operations
@@ -41,12 +41,18 @@ type WarehouseServiceImpl struct {
 // GetAll retrieves all warehouses from the repository
 // GetAll recupera todos los almacenes del repositorio
 func (s *WarehouseServiceImpl) GetAll(ctx context.Context) ([]models.Warehouse, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.GetAll")
+	defer span.End()
+
 	return s.warehouseRepository.GetAll(ctx)
 }
 
 // Create creates a new warehouse in the repository
 // Create crea un nuevo almacén en el repositorio
 func (s *WarehouseServiceImpl) Create(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.Create")
+	defer span.End()
+
 	return s.warehouseRepository.Create(ctx, warehouse)
 }
 
@@ -55,10 +61,13 @@ func (s *WarehouseServiceImpl) Create(ctx context.Context, warehouse models.Ware
 // ValidateCodeUniqueness valida que un código de almacén sea único en el sistema
 // Retorna un error si el código ya existe o si hay un error interno del servidor
 func (s *WarehouseServiceImpl) ValidateCodeUniqueness(ctx context.Context, code string) error {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.ValidateCodeUniqueness")
+	defer span.End()
+
 	// Check if warehouse code already exists / Verificar si el código de almacén ya existe
 	exists, err := s.warehouseRepository.ExistsByCode(ctx, code)
 	if err != nil {
-		return fmt.Errorf("%w: %v", error_message.ErrInternalServerError, err)
+		return internalError(ctx, err)
 	}
 
 	// Return error if code already exists / Retornar error si el código ya existe
@@ -72,20 +81,55 @@ func (s *WarehouseServiceImpl) ValidateCodeUniqueness(ctx context.Context, code
 // GetById retrieves a warehouse by its ID from the repository
 // GetById recupera un almacén por su ID del repositorio
 func (s *WarehouseServiceImpl) GetById(ctx context.Context, id int) (models.Warehouse, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.GetById")
+	defer span.End()
+
 	return s.warehouseRepository.GetById(ctx, id)
 }
 
 // Delete removes a warehouse by its ID from the repository
+// Refuses to delete when dependent rows exist unless force is true
 // Delete elimina un almacén por su ID del repositorio
-func (s *WarehouseServiceImpl) Delete(ctx context.Context, id int) error {
+// Rechaza el borrado cuando existen filas dependientes salvo que force sea true
+func (s *WarehouseServiceImpl) Delete(ctx context.Context, id int, force bool) error {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.Delete")
+	defer span.End()
+
+	// Compute impact first, which also validates existence / Calcular el impacto primero, lo que además valida la existencia
+	impact, err := s.DeleteImpact(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Guard against cascading deletes / Proteger contra borrados en cascada
+	if !force && impact.Total() > 0 {
+		return fmt.Errorf("%w: warehouse with id %d has %d dependent records, use force=true to delete them",
+			error_message.ErrHasDependents, id, impact.Total())
+	}
+
 	return s.warehouseRepository.Delete(ctx, id)
 }
 
+// DeleteImpact returns the dependent rows that deleting the warehouse would remove
+// DeleteImpact retorna las filas dependientes que eliminaría el borrado del almacén
+func (s *WarehouseServiceImpl) DeleteImpact(ctx context.Context, id int) (models.DeleteImpact, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.DeleteImpact")
+	defer span.End()
+
+	if _, err := s.warehouseRepository.GetById(ctx, id); err != nil {
+		return models.DeleteImpact{}, err
+	}
+	return s.warehouseRepository.CountDependents(ctx, id)
+}
+
 // Update modifies an existing warehouse with business validation for code uniqueness
 // Validates code uniqueness only if the warehouse code has changed
 // Update modifica un almacén existente con validación de negocio para unicidad de código
 // Valida la unicidad del código solo si el código del almacén ha cambiado
 func (s *WarehouseServiceImpl) Update(ctx context.Context, id int, warehouse models.Warehouse) (models.Warehouse, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.Update")
+	defer span.End()
+
 	// Get current warehouse to compare codes / Obtener almacén actual para comparar códigos
 	currentWarehouse, err := s.warehouseRepository.GetById(ctx, id)
 	if err != nil {
@@ -102,3 +146,107 @@ func (s *WarehouseServiceImpl) Update(ctx context.Context, id int, warehouse mod
 	// Update warehouse after validation / Actualizar almacén después de la validación
 	return s.warehouseRepository.Update(ctx, id, warehouse)
 }
+
+// GetStock returns the warehouse stock per section and product, with the capacity utilization of each section
+// GetStock retorna el stock del almacén por sección y producto, con la utilización de capacidad de cada sección
+func (s *WarehouseServiceImpl) GetStock(ctx context.Context, id int) (models.WarehouseStock, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.GetStock")
+	defer span.End()
+
+	warehouse, err := s.warehouseRepository.GetById(ctx, id)
+	if err != nil {
+		return models.WarehouseStock{}, err
+	}
+
+	rows, err := s.warehouseRepository.GetStock(ctx, id)
+	if err != nil {
+		return models.WarehouseStock{}, err
+	}
+
+	// Rows come ordered by section, so a new section id starts a new entry
+	// Las filas vienen ordenadas por sección, así que un id de sección nuevo inicia una nueva entrada
+	stock := models.WarehouseStock{WarehouseId: warehouse.Id, WarehouseCode: warehouse.WareHouseCode, Sections: []models.SectionStock{}}
+	for _, row := range rows {
+		last := len(stock.Sections) - 1
+		if last < 0 || stock.Sections[last].SectionId != row.SectionId {
+			stock.Sections = append(stock.Sections, models.SectionStock{
+				SectionId:       row.SectionId,
+				SectionNumber:   row.SectionNumber,
+				MaximumCapacity: row.MaximumCapacity,
+				MaximumVolume:   row.MaximumVolume,
+				MaximumWeight:   row.MaximumWeight,
+				Products:        []models.ProductStock{},
+			})
+			last++
+		}
+
+		// Sections without batches have no product row / Las secciones sin lotes no tienen fila de producto
+		if row.ProductId == 0 {
+			continue
+		}
+
+		section := &stock.Sections[last]
+		section.Products = append(section.Products, models.ProductStock{
+			ProductId:       row.ProductId,
+			ProductCode:     row.ProductCode,
+			Description:     row.ProductDescription,
+			Batches:         row.Batches,
+			Quantity:        row.Quantity,
+			Volume:          row.Volume,
+			Weight:          row.Weight,
+			EarliestDueDate: row.EarliestDueDate,
+		})
+		section.Quantity += row.Quantity
+		section.Volume += row.Volume
+		section.Weight += row.Weight
+		section.EarliestDueDate = earliest(section.EarliestDueDate, row.EarliestDueDate)
+	}
+
+	for i := range stock.Sections {
+		section := &stock.Sections[i]
+		section.Utilization = utilization(float64(section.Quantity), float64(section.MaximumCapacity))
+		section.VolumeUtilization = limitUtilization(section.Volume, section.MaximumVolume)
+		section.WeightUtilization = limitUtilization(section.Weight, section.MaximumWeight)
+		stock.Quantity += section.Quantity
+		stock.EarliestDueDate = earliest(stock.EarliestDueDate, section.EarliestDueDate)
+	}
+
+	return stock, nil
+}
+
+// utilization returns quantity as a percentage of capacity rounded to two decimals; zero capacity reports zero
+// utilization retorna la cantidad como porcentaje de la capacidad redondeado a dos decimales; capacidad cero reporta cero
+func utilization(quantity, capacity float64) float64 {
+	if capacity <= 0 {
+		return 0
+	}
+	return math.Round(quantity*10000/capacity) / 100
+}
+
+// limitUtilization returns the utilization against an optional limit, nil when the limit is not declared
+// limitUtilization retorna la utilización contra un límite opcional, nil cuando el límite no está declarado
+func limitUtilization(used float64, limit *float64) *float64 {
+	if limit == nil {
+		return nil
+	}
+	percentage := utilization(used, *limit)
+	return &percentage
+}
+
+// earliest returns the earlier of two optional dates
+// earliest retorna la fecha más temprana entre dos fechas opcionales
+func earliest(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+	return a
+}
+
+// GetStockTotals returns the units stored in every warehouse
+// GetStockTotals retorna las unidades guardadas en cada almacén
+func (s *WarehouseServiceImpl) GetStockTotals(ctx context.Context) ([]models.WarehouseStockTotal, error) {
+	ctx, span := tracing.Start(ctx, "services.WarehouseServiceImpl.GetStockTotals")
+	defer span.End()
+
+	return s.warehouseRepository.GetStockTotals(ctx)
+}
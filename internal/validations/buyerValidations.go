@@ -1,9 +1,10 @@
 package validations
 
 import (
-	"errors"
+	"fmt"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/error_message"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 )
 
@@ -23,5 +24,5 @@ func IsNotAnEmptyBuyer(r requests.BuyerRequest) error {
 	if r.CardNumberId != "" || r.FirstName != "" || r.LastName != "" {
 		return nil
 	}
-	return errors.New("at least one of id_card_number, first_name, or last_name is required")
+	return fmt.Errorf("%w: at least one of id_card_number, first_name, or last_name is required", error_message.ErrInvalidInput)
 }
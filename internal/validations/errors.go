@@ -0,0 +1,10 @@
+package validations
+
+import validation "github.com/go-ozzo/ozzo-validation/v4"
+
+// Custom validation errors; their codes have a translated message in the i18n catalog
+// Errores de validación propios; sus códigos tienen un mensaje traducido en el catálogo de i18n
+var (
+	errStorageClassInvalid     = validation.NewError("validation_storage_class_invalid", "must be one of frozen, chilled or ambient")
+	errTemperatureRangeInvalid = validation.NewError("validation_temperature_range_invalid", "must be greater than or equal to minimum_temperature")
+)
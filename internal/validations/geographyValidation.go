@@ -0,0 +1,32 @@
+package validations
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+)
+
+func ValidateCountryPatchRequest(r requests.CountryPatchRequest) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.CountryName, validation.When(r.CountryName != nil, validation.Required)),
+	)
+}
+
+func ValidateProvincePatchRequest(r requests.ProvincePatchRequest) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ProvinceName, validation.When(r.ProvinceName != nil, validation.Required)),
+		validation.Field(&r.CountryId, validation.When(r.CountryId != nil, validation.Required, validation.Min(1))),
+	)
+}
+
+func ValidateLocalityPatchRequest(r requests.LocalityPatchRequest) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.LocalityName, validation.When(r.LocalityName != nil, validation.Required)),
+		validation.Field(&r.ProvinceId, validation.When(r.ProvinceId != nil, validation.Required, validation.Min(1))),
+	)
+}
+
+func ValidateGeographyMergeRequest(r requests.GeographyMergeRequest) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TargetId, validation.Required, validation.Min(1)),
+	)
+}
@@ -0,0 +1,30 @@
+package validations
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
+	"github.com/sajimenezher_meli/meli-frescos-8/internal/models"
+)
+
+func storageClassRule() validation.Rule {
+	classes := make([]interface{}, len(models.StorageClasses))
+	for i, class := range models.StorageClasses {
+		classes[i] = class
+	}
+	return validation.In(classes...).ErrorObject(errStorageClassInvalid)
+}
+
+func ValidateProductTypeRequestStruct(r requests.ProductTypeRequest) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Description, validation.Required),
+		validation.Field(&r.StorageClass, validation.Required, storageClassRule()),
+		validation.Field(&r.MaximumTemperature, validation.Min(r.MinimumTemperature).ErrorObject(errTemperatureRangeInvalid)),
+	)
+}
+
+func ValidateProductTypePatchRequest(r requests.ProductTypePatchRequest) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Description, validation.When(r.Description != nil, validation.Required)),
+		validation.Field(&r.StorageClass, validation.When(r.StorageClass != nil, validation.Required, storageClassRule())),
+	)
+}
@@ -1,7 +1,7 @@
 package validations
 
 import (
-	validation "github.com/go-ozzo/ozzo-validation"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/handlers/requests"
 )
 
@@ -20,6 +20,8 @@ func (v SectionValidation) ValidateSectionRequestStruct(r requests.SectionReques
 		validation.Field(&r.MaximumCapacity, validation.Required),
 		validation.Field(&r.MinimumCapacity, validation.Required),
 		validation.Field(&r.MinimumTemperature, validation.Required),
+		validation.Field(&r.MaximumVolume, validation.Min(0.0).Exclusive()),
+		validation.Field(&r.MaximumWeight, validation.Min(0.0).Exclusive()),
 		validation.Field(&r.ProductTypeID, validation.Required),
 		validation.Field(&r.WarehouseID, validation.Required),
 	)
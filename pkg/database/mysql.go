@@ -4,31 +4,86 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
+	"strconv"
+	"time"
 
+	"github.com/XSAM/otelsql"
 	"github.com/sajimenezher_meli/meli-frescos-8/internal/config"
 	tools "github.com/sajimenezher_meli/meli-frescos-8/pkg"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
 
-	_ "github.com/go-sql-driver/mysql" // MySQL driver
+	"github.com/go-sql-driver/mysql" // MySQL driver
 )
 
-// InitDB initializes and returns a MySQL database connection
-func InitDB(cfg *config.Config) *sql.DB {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true",
-		cfg.Database.DBUser, cfg.Database.DBPassword, cfg.Database.DBHost, cfg.Database.DBPort, cfg.Database.DBName)
-
-	db, err := sql.Open("mysql", dsn)
+// maxConnectBackoff caps the wait between startup pings / maxConnectBackoff limita la espera entre pings al iniciar
+const maxConnectBackoff = 30 * time.Second
+
+// InitDB opens the MySQL pool with the configured limits and pings it until it answers
+// A failed ping is retried up to DB_CONNECT_ATTEMPTS times, doubling the wait from DB_CONNECT_BACKOFF, and gives up when ctx ends
+// InitDB abre el pool de MySQL con los límites configurados y hace ping hasta que responda
+// Un ping fallido se reintenta hasta DB_CONNECT_ATTEMPTS veces, duplicando la espera desde DB_CONNECT_BACKOFF, y se abandona cuando termina ctx
+func InitDB(ctx context.Context, cfg *config.Config) (*sql.DB, error) {
+	// Every query, including the ones run by the helpers below, becomes a span of the trace in ctx
+	// Cada consulta, incluidas las de los helpers de abajo, se vuelve un span de la traza en ctx
+	db, err := otelsql.Open("mysql", DSN(cfg),
+		otelsql.WithAttributes(semconv.DBSystemNameMySQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
-		log.Fatalf("Error opening database: %v", err)
+		return nil, fmt.Errorf("error opening database: %w", err)
 	}
 
-	err = db.Ping()
-	if err != nil {
-		log.Fatalf("Error connecting to the database: %v", err)
+	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.Database.ConnMaxIdleTime)
+
+	backoff := cfg.Database.ConnectBackoff
+	for attempt := 1; ; attempt++ {
+		err = db.PingContext(ctx)
+		if err == nil {
+			break
+		}
+		if attempt >= cfg.Database.ConnectAttempts {
+			db.Close()
+			return nil, fmt.Errorf("error connecting to the database after %d attempts: %w", attempt, err)
+		}
+
+		slog.WarnContext(ctx, "database not ready, retrying", "attempt", attempt, "attempts", cfg.Database.ConnectAttempts, "retry_in", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			db.Close()
+			return nil, fmt.Errorf("error connecting to the database: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxConnectBackoff)
 	}
 
-	log.Println("Successfully connected to MySQL!")
-	return db
+	slog.InfoContext(ctx, "connected to MySQL", "addr", net.JoinHostPort(cfg.Database.DBHost, strconv.Itoa(cfg.Database.DBPort)))
+	return db, nil
+}
+
+// DSN builds the driver connection string from the database settings / DSN arma el string de conexión del driver con la configuración de la base
+func DSN(cfg *config.Config) string {
+	dsn := mysql.NewConfig()
+	dsn.User = cfg.Database.DBUser
+	dsn.Passwd = cfg.Database.DBPassword
+	dsn.Net = "tcp"
+	dsn.Addr = net.JoinHostPort(cfg.Database.DBHost, strconv.Itoa(cfg.Database.DBPort))
+	dsn.DBName = cfg.Database.DBName
+	dsn.ParseTime = true
+	dsn.TLSConfig = cfg.Database.TLS
+	dsn.Timeout = cfg.Database.DialTimeout
+	dsn.ReadTimeout = cfg.Database.ReadTimeout
+	dsn.WriteTimeout = cfg.Database.WriteTimeout
+	dsn.Collation = cfg.Database.Collation
+	return dsn.FormatDSN()
 }
 
 func SelectOne(ctx context.Context, db *sql.DB, tablename string, fields []string, condition string, values ...any) *sql.Row {
@@ -31,3 +31,41 @@ func GetSlicesOfKeyAndValuesFromMap(m map[any]any) ([]any, []any) {
 func ConvertStringToDate(date string) (time.Time, error) {
 	return time.Parse("2006-01-02", date)
 }
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "ä", "a", "â", "a", "ã", "a",
+	"é", "e", "è", "e", "ë", "e", "ê", "e",
+	"í", "i", "ì", "i", "ï", "i", "î", "i",
+	"ó", "o", "ò", "o", "ö", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ù", "u", "ü", "u", "û", "u",
+	"ñ", "n", "ç", "c",
+)
+
+// NormalizeName lowercases, strips accents and collapses spaces so "  Córdoba " and "cordoba" compare equal
+// NormalizeName pasa a minúsculas, quita acentos y colapsa espacios para que "  Córdoba " y "cordoba" sean iguales
+func NormalizeName(name string) string {
+	return accentReplacer.Replace(strings.Join(strings.Fields(strings.ToLower(name)), " "))
+}
+
+// LevenshteinDistance counts the single rune insertions, deletions or substitutions needed to turn a into b
+// LevenshteinDistance cuenta las inserciones, borrados o sustituciones de una runa necesarias para convertir a en b
+func LevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,51 @@
+package tools
+
+import "testing"
+
+// TestNormalizeName checks case, accents and every kind of whitespace are folded
+// TestNormalizeName verifica que se unifiquen mayúsculas, acentos y todo tipo de espacio
+func TestNormalizeName(t *testing.T) {
+	cases := []struct {
+		name, expected string
+	}{
+		{"Córdoba", "cordoba"},
+		{"  Córdoba ", "cordoba"},
+		{"San   Martín", "san martin"},
+		{"São\tPaulo\n", "sao paulo"},
+		{"ÑUÑOA", "nunoa"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		if got := NormalizeName(tc.name); got != tc.expected {
+			t.Errorf("NormalizeName(%q) = %q, expected %q", tc.name, got, tc.expected)
+		}
+	}
+}
+
+// TestLevenshteinDistance checks the distance is symmetric and counts runes, not bytes
+// TestLevenshteinDistance verifica que la distancia sea simétrica y cuente runas, no bytes
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"cordoba", "cordoba", 0},
+		{"cordoba", "cordova", 1},
+		{"cordoba", "crdoba", 1},
+		{"kitten", "sitting", 3},
+		{"añil", "anil", 1},
+	}
+
+	for _, tc := range cases {
+		if got := LevenshteinDistance(tc.a, tc.b); got != tc.expected {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, expected %d", tc.a, tc.b, got, tc.expected)
+		}
+		if got := LevenshteinDistance(tc.b, tc.a); got != tc.expected {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, expected %d", tc.b, tc.a, got, tc.expected)
+		}
+	}
+}